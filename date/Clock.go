@@ -0,0 +1,33 @@
+package date
+
+import (
+	"time"
+)
+
+// Clock tells the date, so that anything which depends on today's date can be run as of another date.
+type Clock interface {
+	// Today returns the current date.
+	Today() Date
+}
+
+type systemClock struct{}
+
+// SystemClock is the Clock which reads the date from the system clock in the local time zone.
+var SystemClock Clock = systemClock{}
+
+func (systemClock) Today() Date {
+	year, month, day := time.Now().Date()
+	return NewDate(year, month, day)
+}
+
+// Today returns the current date according to the SystemClock.
+func Today() Date {
+	return SystemClock.Today()
+}
+
+// FixedClock is a Clock which is always on the same date, for tests and for rerunning reports as of a past date.
+type FixedClock Date
+
+func (c FixedClock) Today() Date {
+	return Date(c)
+}
@@ -0,0 +1,31 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_FixedClockIsAlwaysOnTheSameDate(t *testing.T) {
+	// Given a clock fixed on last spring's report date
+	clock := FixedClock(NewDate(2025, time.April, 15))
+
+	// Then today is always that date
+	if today := clock.Today(); today != NewDate(2025, time.April, 15) {
+		t.Fatalf("Expected today to be 04/15/2025 got %v", today)
+	}
+}
+
+func Test_SystemClockIsOnTheLocalDate(t *testing.T) {
+	// When I ask the system clock for today
+	before := time.Now()
+	today := Today()
+	after := time.Now()
+
+	// Then it is the local date with no time of day
+	if !today.Equal(Date{before}) && !today.Equal(Date{after}) {
+		t.Fatalf("Expected today to be %v got %v", before.Format(ScoutbookLayout), today)
+	}
+	if today.Hour() != 0 || today.Minute() != 0 || today.Location() != time.UTC {
+		t.Fatalf("Expected today to be midnight UTC got %v", today.Time)
+	}
+}
@@ -1,8 +1,10 @@
 package date
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -15,22 +17,141 @@ func NewDate(year int, month time.Month, day int) Date {
 	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
 }
 
+// ParseDate strictly parses a date in the ScoutbookLayout.
 func ParseDate(value string) (Date, error) {
-	t, err := time.Parse("01/02/2006", value)
+	t, err := time.Parse(ScoutbookLayout, value)
 	if err != nil {
 		return Date{}, err
 	}
 	return Date{t}, nil
 }
 
+// IsZero reports whether the date is the zero Date, which stands for an unknown date.
+func (d Date) IsZero() bool {
+	return d.Time.IsZero()
+}
+
+// AddDays returns the date the given number of days after d, or before d when days is negative.
+func (d Date) AddDays(days int) Date {
+	year, month, day := d.Date()
+	return NewDate(year, month, day+days)
+}
+
+// AddMonths returns the date the given number of months after d, or before d when months is negative.  When the
+// resulting month is too short for the day of d, the last day of the month is used, so January 31st plus one month is
+// the end of February.
+func (d Date) AddMonths(months int) Date {
+	year, month, day := d.Date()
+	target := NewDate(year, month+time.Month(months), 1)
+	return NewDate(target.Year(), target.Month(), min(day, target.EndOfMonth().Day()))
+}
+
+// AddYears returns the date the given number of years after d, or before d when years is negative.  February 29th
+// becomes February 28th in a common year.
+func (d Date) AddYears(years int) Date {
+	return d.AddMonths(12 * years)
+}
+
+// EndOfMonth returns the last day of the month d is in.
+func (d Date) EndOfMonth() Date {
+	year, month, _ := d.Date()
+	// Day 0 of the next month is the last day of this month
+	return NewDate(year, month+1, 0)
+}
+
+// DaysUntil returns the number of days from d until other, which is negative when other is before d.
+func (d Date) DaysUntil(other Date) int {
+	// Counted from Unix seconds rather than a time.Duration, which overflows past about 292 years
+	return int((other.midnight().Unix() - d.midnight().Unix()) / secondsPerDay)
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// Compare returns -1 if d is before other, 0 if they are the same date and +1 if d is after other.
+func (d Date) Compare(other Date) int {
+	return d.midnight().Compare(other.midnight())
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether d is after other.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// Equal reports whether d and other are the same date.
+func (d Date) Equal(other Date) bool {
+	return d.Compare(other) == 0
+}
+
+// midnight returns the start of the date in UTC, so that dates compare without regard to the time of day or the
+// location they were created in.
+func (d Date) midnight() time.Time {
+	year, month, day := d.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Layouts accepted by ParseDateLenient, in the order they are tried.
+const (
+	// ScoutbookLayout is the layout of dates in Scoutbook exports, which is the only layout ParseDate accepts.
+	ScoutbookLayout = "01/02/2006"
+	// UsLayout is a US date with or without zero padding, such as "1/2/2006".
+	UsLayout = "1/2/2006"
+	// IsoLayout is an ISO 8601 date such as "2006-01-02".
+	IsoLayout = "2006-01-02"
+	// ShortYearLayout is a US date with a two digit year, such as "1/2/06".  Years 69 to 99 are in the 1900s and
+	// years 00 to 68 are in the 2000s.
+	ShortYearLayout = "1/2/06"
+)
+
+var lenientLayouts = []string{ScoutbookLayout, UsLayout, IsoLayout, ShortYearLayout}
+
+// ParseDateLenient parses a date written in any of the common US or ISO layouts, such as hand entered dates or dates
+// from other systems, and returns the layout which matched.  Use ParseDate for Scoutbook exports, which should never
+// need the other layouts.
+func ParseDateLenient(value string) (Date, string, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range lenientLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return Date{t}, layout, nil
+		}
+	}
+	return Date{}, "", fmt.Errorf("%q is not a date in any of the layouts %q", value, lenientLayouts)
+}
+
 func (d Date) String() string {
-	return d.Format("01/02/2006")
+	return d.Format(ScoutbookLayout)
 }
 
+// MarshalJSON writes the date as "01/02/2006", or null for the zero Date.
 func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
 	return []byte(fmt.Sprintf(`"%s"`, d.String())), nil
 }
 
+// UnmarshalJSON reads a date written by MarshalJSON.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalJSON parses a JSON string holding a date.
+//
+// Deprecated: use the UnmarshalJSON method of Date, which json.Unmarshal calls for Date fields.
 func UnmarshalJSON(data []byte) (Date, error) {
 	var dateStr string
 	if err := json.Unmarshal(data, &dateStr); err != nil {
@@ -43,3 +164,73 @@ func UnmarshalJSON(data []byte) (Date, error) {
 	}
 	return parsed, nil
 }
+
+// MarshalText writes the date as "01/02/2006", or as empty text for the zero Date.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText reads a date written by MarshalText.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Scan reads a date from a database column holding a date, a timestamp or text in the IsoLayout, as databases without
+// a date type usually store dates, or the ScoutbookLayout.  Only the calendar date of a timestamp is kept.  NULL is
+// the zero Date.
+func (d *Date) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = NewDate(value.Year(), value.Month(), value.Day())
+		return nil
+	case string:
+		return d.scanText(value)
+	case []byte:
+		return d.scanText(string(value))
+	default:
+		return fmt.Errorf("cannot scan %T into a date", src)
+	}
+}
+
+func (d *Date) scanText(value string) error {
+	if value == "" {
+		*d = Date{}
+		return nil
+	}
+
+	if t, err := time.Parse(IsoLayout, value); err == nil {
+		*d = Date{t}
+		return nil
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value writes the date to a database as midnight UTC on the date, or NULL for the zero Date.
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.midnight(), nil
+}
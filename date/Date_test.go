@@ -0,0 +1,245 @@
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func Test_DateRoundTripsThroughJson(t *testing.T) {
+	for _, expected := range []Date{NewDate(2025, time.May, 6), {}} {
+		// When I write the date as JSON and read it back
+		data, err := json.Marshal(expected)
+		if err != nil {
+			t.Fatalf("Failed to write json: %v", err)
+		}
+		var actual Date
+		if err := json.Unmarshal(data, &actual); err != nil {
+			t.Fatalf("Failed to read json %s: %v", data, err)
+		}
+
+		// Then the date is unchanged
+		if actual != expected {
+			t.Fatalf("Expected %v got %v from %s", expected, actual, data)
+		}
+	}
+}
+
+func Test_DateRoundTripsThroughText(t *testing.T) {
+	for _, expected := range []Date{NewDate(2025, time.May, 6), {}} {
+		// When I write the date as text and read it back
+		text, err := expected.MarshalText()
+		if err != nil {
+			t.Fatalf("Failed to write text: %v", err)
+		}
+		actual := NewDate(1999, time.December, 31)
+		if err := actual.UnmarshalText(text); err != nil {
+			t.Fatalf("Failed to read text %q: %v", text, err)
+		}
+
+		// Then the date is unchanged
+		if actual != expected {
+			t.Fatalf("Expected %v got %v from %q", expected, actual, text)
+		}
+	}
+}
+
+func Test_DateRoundTripsThroughSql(t *testing.T) {
+	for _, expected := range []Date{NewDate(2025, time.May, 6), {}} {
+		// When I write the date to a database and scan it back
+		value, err := expected.Value()
+		if err != nil {
+			t.Fatalf("Failed to write value: %v", err)
+		}
+		actual := NewDate(1999, time.December, 31)
+		if err := actual.Scan(value); err != nil {
+			t.Fatalf("Failed to scan %v: %v", value, err)
+		}
+
+		// Then the date is unchanged
+		if actual != expected {
+			t.Fatalf("Expected %v got %v from %v", expected, actual, value)
+		}
+	}
+}
+
+func Test_DateValueIsMidnightUtc(t *testing.T) {
+	// Given a date taken from a time of day in another zone
+	d := Date{time.Date(2025, time.May, 6, 23, 30, 0, 0, time.FixedZone("MDT", -6*60*60))}
+
+	// When I write the date to a database
+	value, err := d.Value()
+	if err != nil {
+		t.Fatalf("Failed to write value: %v", err)
+	}
+
+	// Then the value is the start of the date in UTC
+	expected := time.Date(2025, time.May, 6, 0, 0, 0, 0, time.UTC)
+	if value != expected {
+		t.Fatalf("Expected %v got %v", expected, value)
+	}
+}
+
+func Test_DateScansDatabaseValues(t *testing.T) {
+	expected := NewDate(2025, time.May, 6)
+	testCases := []struct {
+		name string
+		src  any
+	}{
+		{"timestamp in another zone", time.Date(2025, time.May, 6, 23, 30, 0, 0, time.FixedZone("MDT", -6*60*60))},
+		{"iso text", "2025-05-06"},
+		{"scoutbook text", []byte("05/06/2025")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When I scan the value
+			var actual Date
+			if err := actual.Scan(tc.src); err != nil {
+				t.Fatalf("Failed to scan %v: %v", tc.src, err)
+			}
+
+			// Then only the calendar date is kept
+			if actual != expected {
+				t.Fatalf("Expected %v got %v", expected, actual)
+			}
+		})
+	}
+}
+
+func Test_AddMonthsClampsToTheEndOfTheMonth(t *testing.T) {
+	testCases := []struct {
+		date     Date
+		months   int
+		expected Date
+	}{
+		{NewDate(2025, time.May, 6), 12, NewDate(2026, time.May, 6)},
+		{NewDate(2025, time.January, 31), 1, NewDate(2025, time.February, 28)},
+		{NewDate(2024, time.January, 31), 1, NewDate(2024, time.February, 29)},
+		{NewDate(2024, time.February, 29), 12, NewDate(2025, time.February, 28)},
+		{NewDate(2023, time.August, 31), 36, NewDate(2026, time.August, 31)},
+		{NewDate(2025, time.March, 31), -1, NewDate(2025, time.February, 28)},
+		{NewDate(2025, time.November, 30), 3, NewDate(2026, time.February, 28)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date.String(), func(t *testing.T) {
+			// When I add months to the date
+			actual := tc.date.AddMonths(tc.months)
+
+			// Then the day is kept unless the month is too short for it
+			if actual != tc.expected {
+				t.Fatalf("Expected %v plus %d months to be %v got %v", tc.date, tc.months, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_DateArithmeticIgnoresTimeOfDay(t *testing.T) {
+	// Given the same date late in the evening in Denver and early in the morning in UTC
+	denver := Date{time.Date(2025, time.March, 8, 23, 0, 0, 0, time.FixedZone("MST", -7*60*60))}
+	utc := NewDate(2025, time.March, 8)
+
+	// Then they are the same date
+	if !denver.Equal(utc) || denver.Before(utc) || denver.After(utc) || denver.DaysUntil(utc) != 0 {
+		t.Fatalf("Expected %v and %v to be the same date", denver, utc)
+	}
+
+	// And days are counted across the change to daylight saving time
+	if days := denver.DaysUntil(NewDate(2025, time.March, 10)); days != 2 {
+		t.Fatalf("Expected 2 days got %d", days)
+	}
+	if days := NewDate(2025, time.March, 10).DaysUntil(denver); days != -2 {
+		t.Fatalf("Expected -2 days got %d", days)
+	}
+}
+
+func Test_DaysUntilCountsSpansLongerThanADuration(t *testing.T) {
+	// Given dates centuries apart
+	testCases := []struct {
+		name     string
+		from     Date
+		to       Date
+		expected int
+	}{
+		{"from the zero Date", Date{}, NewDate(2025, time.January, 1), 739251},
+		{"back to the zero Date", NewDate(2025, time.January, 1), Date{}, -739251},
+		{"across every year", NewDate(1, time.January, 1), NewDate(9999, time.December, 31), 3652058},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When I count the days between them
+			actual := tc.from.DaysUntil(tc.to)
+
+			// Then every day is counted
+			if actual != tc.expected {
+				t.Fatalf("Expected %d days got %d", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_AddDaysAndEndOfMonth(t *testing.T) {
+	if actual := NewDate(2024, time.December, 30).AddDays(3); actual != NewDate(2025, time.January, 2) {
+		t.Fatalf("Expected 01/02/2025 got %v", actual)
+	}
+	if actual := NewDate(2024, time.February, 10).EndOfMonth(); actual != NewDate(2024, time.February, 29) {
+		t.Fatalf("Expected 02/29/2024 got %v", actual)
+	}
+	if actual := NewDate(2024, time.February, 29).AddYears(1); actual != NewDate(2025, time.February, 28) {
+		t.Fatalf("Expected 02/28/2025 got %v", actual)
+	}
+}
+
+func Test_ZeroDateIsUnknown(t *testing.T) {
+	if !(Date{}).IsZero() || NewDate(2025, time.May, 6).IsZero() {
+		t.Fatalf("Expected only the zero Date to be zero")
+	}
+}
+
+func Test_ParseDateLenientReportsTheMatchingLayout(t *testing.T) {
+	testCases := []struct {
+		value          string
+		expected       Date
+		expectedLayout string
+	}{
+		{"01/02/2006", NewDate(2006, time.January, 2), ScoutbookLayout},
+		{"1/2/2006", NewDate(2006, time.January, 2), UsLayout},
+		{"12/5/2024", NewDate(2024, time.December, 5), UsLayout},
+		{"2006-01-02", NewDate(2006, time.January, 2), IsoLayout},
+		{" 1/2/06 ", NewDate(2006, time.January, 2), ShortYearLayout},
+		{"7/4/76", NewDate(1976, time.July, 4), ShortYearLayout},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			// When I parse the date leniently
+			actual, layout, err := ParseDateLenient(tc.value)
+			if err != nil {
+				t.Fatalf("Failed to parse %q: %v", tc.value, err)
+			}
+
+			// Then the date and the layout which matched are returned
+			if actual != tc.expected || layout != tc.expectedLayout {
+				t.Fatalf("Expected %v in %q got %v in %q", tc.expected, tc.expectedLayout, actual, layout)
+			}
+		})
+	}
+}
+
+func Test_ParseDateStaysStrict(t *testing.T) {
+	// Given dates which only the lenient parser accepts
+	for _, value := range []string{"1/2/2006", "2006-01-02", "1/2/06"} {
+		// When I parse them strictly
+		if _, err := ParseDate(value); err == nil {
+			// Then they are rejected
+			t.Fatalf("Expected ParseDate to reject %q", value)
+		}
+	}
+
+	// And the lenient parser still rejects values which are not dates
+	if _, _, err := ParseDateLenient("July 4th"); err == nil {
+		t.Fatalf("Expected ParseDateLenient to reject July 4th")
+	}
+}
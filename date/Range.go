@@ -0,0 +1,81 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Range is a span of dates, such as a trip or a camp session.  Both the Start and the End are part of the range.
+type Range struct {
+	Start Date
+	End   Date
+}
+
+// NewRange returns the range from start through end.  An error is returned if end is before start.
+func NewRange(start Date, end Date) (Range, error) {
+	if end.Before(start) {
+		return Range{}, fmt.Errorf("range ends on %v before it starts on %v", end, start)
+	}
+	return Range{Start: start, End: end}, nil
+}
+
+// ParseRange parses a range written as "01/02/2006-01/05/2006".
+func ParseRange(value string) (Range, error) {
+	start, end, found := strings.Cut(value, "-")
+	if !found {
+		return Range{}, fmt.Errorf("%q is not a range of dates", value)
+	}
+
+	startDate, err := ParseDate(strings.TrimSpace(start))
+	if err != nil {
+		return Range{}, err
+	}
+	endDate, err := ParseDate(strings.TrimSpace(end))
+	if err != nil {
+		return Range{}, err
+	}
+	return NewRange(startDate, endDate)
+}
+
+// Contains reports whether d is in the range.
+func (r Range) Contains(d Date) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// Overlaps reports whether the ranges have any date in common.
+func (r Range) Overlaps(other Range) bool {
+	return !r.Start.After(other.End) && !other.Start.After(r.End)
+}
+
+// Days returns the number of days in the range, counting both the Start and the End.
+func (r Range) Days() int {
+	return r.Start.DaysUntil(r.End) + 1
+}
+
+func (r Range) String() string {
+	return r.Start.String() + "-" + r.End.String()
+}
+
+// ProgramYearStart is the month the BSA program year starts in.
+const ProgramYearStart = time.September
+
+// ProgramYear returns the BSA program year holding d, which runs from September 1st through August 31st.
+func ProgramYear(d Date) Range {
+	year := d.Year()
+	if d.Month() < ProgramYearStart {
+		year--
+	}
+	start := NewDate(year, ProgramYearStart, 1)
+	return Range{Start: start, End: start.AddYears(1).AddDays(-1)}
+}
+
+// RecharterYear returns the 12 month charter term holding d for a unit whose charter expires at the end of
+// charterMonth.  Most units recharter in December, which makes the recharter year the calendar year.
+func RecharterYear(d Date, charterMonth time.Month) Range {
+	end := NewDate(d.Year(), charterMonth, 1).EndOfMonth()
+	if end.Before(d) {
+		end = end.AddDays(1).AddMonths(12).AddDays(-1)
+	}
+	return Range{Start: end.AddDays(1).AddMonths(-12), End: end}
+}
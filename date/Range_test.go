@@ -0,0 +1,91 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_RangeIncludesBothEnds(t *testing.T) {
+	// Given a camp session
+	camp, err := ParseRange("07/12/2026 - 07/18/2026")
+	if err != nil {
+		t.Fatalf("Failed to parse range: %v", err)
+	}
+
+	// Then it includes its first and last days and nothing outside them
+	if !camp.Contains(NewDate(2026, time.July, 12)) || !camp.Contains(NewDate(2026, time.July, 18)) {
+		t.Fatalf("Expected %v to contain its first and last days", camp)
+	}
+	if camp.Contains(NewDate(2026, time.July, 11)) || camp.Contains(NewDate(2026, time.July, 19)) {
+		t.Fatalf("Expected %v to not contain the days around it", camp)
+	}
+	if camp.Days() != 7 {
+		t.Fatalf("Expected 7 days got %d", camp.Days())
+	}
+}
+
+func Test_RangesOverlap(t *testing.T) {
+	// Given a camp session
+	camp := Range{Start: NewDate(2026, time.July, 12), End: NewDate(2026, time.July, 18)}
+	testCases := []struct {
+		name     string
+		other    Range
+		expected bool
+	}{
+		{"sharing the last day", Range{Start: NewDate(2026, time.July, 18), End: NewDate(2026, time.July, 20)}, true},
+		{"inside", Range{Start: NewDate(2026, time.July, 14), End: NewDate(2026, time.July, 15)}, true},
+		{"the day after", Range{Start: NewDate(2026, time.July, 19), End: NewDate(2026, time.July, 20)}, false},
+		{"the week before", Range{Start: NewDate(2026, time.July, 5), End: NewDate(2026, time.July, 11)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Then it overlaps ranges sharing any day with it, in either direction
+			if camp.Overlaps(tc.other) != tc.expected || tc.other.Overlaps(camp) != tc.expected {
+				t.Fatalf("Expected %v overlapping %v to be %v", camp, tc.other, tc.expected)
+			}
+		})
+	}
+}
+
+func Test_ParseRangeRejectsBadRanges(t *testing.T) {
+	// Given a single date, a range which ends before it starts and a range with a bad date
+	for _, value := range []string{"07/12/2026", "07/18/2026-07/12/2026", "07/12/2026-July 18"} {
+		// Then parsing fails
+		if _, err := ParseRange(value); err == nil {
+			t.Fatalf("Expected %q to be rejected", value)
+		}
+	}
+}
+
+func Test_ProgramYearRunsSeptemberThroughAugust(t *testing.T) {
+	// Given dates at the start, middle and end of the 2025-2026 program year
+	expected := Range{Start: NewDate(2025, time.September, 1), End: NewDate(2026, time.August, 31)}
+	for _, d := range []Date{NewDate(2025, time.September, 1), NewDate(2026, time.March, 15), NewDate(2026, time.August, 31)} {
+		// Then each is in the same program year
+		if actual := ProgramYear(d); actual != expected {
+			t.Fatalf("Expected the program year of %v to be %v got %v", d, expected, actual)
+		}
+	}
+}
+
+func Test_RecharterYearEndsWithTheCharterMonth(t *testing.T) {
+	testCases := []struct {
+		date         Date
+		charterMonth time.Month
+		expected     Range
+	}{
+		{NewDate(2026, time.June, 1), time.December, Range{Start: NewDate(2026, time.January, 1), End: NewDate(2026, time.December, 31)}},
+		{NewDate(2026, time.June, 1), time.February, Range{Start: NewDate(2026, time.March, 1), End: NewDate(2027, time.February, 28)}},
+		{NewDate(2028, time.February, 29), time.February, Range{Start: NewDate(2027, time.March, 1), End: NewDate(2028, time.February, 29)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date.String(), func(t *testing.T) {
+			// Then the recharter year ends on the last day of the charter month
+			if actual := RecharterYear(tc.date, tc.charterMonth); actual != tc.expected {
+				t.Fatalf("Expected %v got %v", tc.expected, actual)
+			}
+		})
+	}
+}
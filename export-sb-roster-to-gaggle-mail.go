@@ -13,36 +13,30 @@ import (
 )
 
 func main() {
-	rosterPath, outputPath := configureFlags()
+	rosterPath, outputPath, nameFormat := configureFlags()
 
-	rosterFile, err := os.Open(rosterPath)
-	if err != nil {
-		fmt.Printf("Error opening roster file: %v\n", err)
-		os.Exit(1)
-	}
-	defer func(f *os.File) {
-		err := f.Close()
-		if err != nil {
-			log.Println("Error closing roster file:", err)
-		}
-	}(rosterFile)
-
-	parser := roster.NewCsvParser()
-
-	scoutbookUsers, err := parser.ParseAdultRoster(rosterFile)
+	adultRoster, err := parseRoster(rosterPath)
 	if err != nil {
 		fmt.Printf("Error parsing roster: %v\n", err)
 		os.Exit(1)
 	}
 
 	var users []user
-	for _, sbu := range scoutbookUsers {
-		users = append(users, createUser(sbu.FirstName, sbu.LastName, sbu.Email))
+	for _, sbu := range adultRoster.Users {
+		users = append(users, createUser(nameFormat, sbu.FirstName, sbu.LastName, sbu.Email))
 	}
 
 	writeOutput(outputPath, users)
 }
 
+// parseRoster parses the adult roster at rosterPath, or the CSV roster from stdin if rosterPath is "-"
+func parseRoster(rosterPath string) (*roster.AdultRoster, error) {
+	if rosterPath == "-" {
+		return roster.NewCsvParser().ParseAdultRoster(os.Stdin)
+	}
+	return roster.NewParserForFile(rosterPath).ParseAdultRosterFile(rosterPath)
+}
+
 // removeDuplicates removes duplicate entries where name and email are the same (case-insensitive)
 func removeDuplicates(users []user) []user {
 	seen := make(map[string]bool)
@@ -114,14 +108,15 @@ func writeOutput(outputPath string, users []user) {
 }
 
 // createUser is a helper function that creates a user from firstName, lastName, and email
-func createUser(firstName, lastName, email string) user {
-	return user{Name: fmt.Sprintf("%s %s", firstName, lastName), Email: email}
+func createUser(nameFormat roster.NameFormat, firstName, lastName, email string) user {
+	return user{Name: nameFormat.Format(firstName, lastName), Email: email}
 }
 
-func configureFlags() (string, string) {
+func configureFlags() (string, string, roster.NameFormat) {
 	// Define command line flags
-	rosterPath := flag.String("roster", "", "Path to adult roster CSV file (required)")
+	rosterPath := flag.String("roster", "", "Path to adult roster CSV or XLSX file, or - to read CSV from stdin (required)")
 	outputPath := flag.String("output", "", "Path to output CSV file, defaults to stdout")
+	nameFormatName := flag.String("name-format", roster.FullName.String(), "How to write names: full, initial-last, last-first or first-only")
 	flag.Parse()
 
 	// Validate required flags
@@ -130,7 +125,14 @@ func configureFlags() (string, string) {
 		flag.Usage()
 		os.Exit(1)
 	}
-	return *rosterPath, *outputPath
+
+	nameFormat, err := roster.ParseNameFormat(*nameFormatName)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		flag.Usage()
+		os.Exit(1)
+	}
+	return *rosterPath, *outputPath, nameFormat
 }
 
 type user struct {
@@ -0,0 +1,152 @@
+package roster
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Column headers used by the Scoutbook Report Manager roster exports.  The headers are found in the second row of
+// the export.
+const (
+	firstNameColumn          = "First Name"
+	lastNameColumn           = "Last Name"
+	emailColumn              = "Email"
+	genderColumn             = "Gender"
+	bsaIdColumn              = "BSA Number"
+	unitNumberColumn         = "Unit Number"
+	dateOfBirthColumn        = "Date of Birth"
+	ageColumn                = "Age"
+	trainingColumn           = "Training"
+	trainingExpirationColumn = "Expiration Date"
+	healthFormsColumn        = "Health Form A/B - Health Form C"
+	swimClassColumn          = "Swim Class"
+	swimClassDateColumn      = "Swim Class Date"
+	positionsColumn          = "Positions"
+	patrolColumn             = "Patrol"
+	phoneColumn              = "Phone"
+	relationshipColumn       = "Relationship"
+	youthFirstNameColumn     = "Youth First Name"
+	youthLastNameColumn      = "Youth Last Name"
+	youthBsaIdColumn         = "Youth BSA Number"
+)
+
+// The required columns must be present in a roster of each type, and must not be blank in any row.  All other columns
+// are optional and are left empty when they are not part of the report.
+var (
+	requiredAdultColumns  = []string{firstNameColumn, lastNameColumn, bsaIdColumn}
+	requiredYouthColumns  = []string{firstNameColumn, lastNameColumn, bsaIdColumn, dateOfBirthColumn}
+	requiredParentColumns = []string{firstNameColumn, lastNameColumn, youthBsaIdColumn}
+)
+
+// MissingColumnsError is returned when the header row of a roster does not contain all the required columns.
+type MissingColumnsError struct {
+	Columns []string
+}
+
+func (e *MissingColumnsError) Error() string {
+	return fmt.Sprintf("roster is missing required columns: %s", strings.Join(e.Columns, ", "))
+}
+
+// columnIndex maps a column header to its position in a record.
+type columnIndex map[string]int
+
+// newColumnIndex builds a columnIndex from the header row.  Headers are matched without regard to case or surrounding
+// whitespace, so extra and reordered columns are tolerated.  A MissingColumnsError is returned listing every required
+// column which could not be found.
+func newColumnIndex(header []string, required []string) (columnIndex, error) {
+	index := columnIndex{}
+	for i, name := range header {
+		key := normalizeHeader(name)
+		if _, exists := index[key]; !exists {
+			index[key] = i
+		}
+	}
+
+	var missing []string
+	for _, name := range required {
+		if _, ok := index[normalizeHeader(name)]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, &MissingColumnsError{Columns: missing}
+	}
+
+	return index, nil
+}
+
+// row is a single roster record along with its line number, so problems with its values can be reported.  The first
+// problem found is kept in err.
+type row struct {
+	columns  columnIndex
+	required []string
+	record   []string
+	line     int
+	err      *ParseError
+}
+
+// value returns the raw value of the named column, or an empty string when the column is not in the roster.  A row
+// that is too short to hold the column is recorded as a ParseError.
+func (r *row) value(name string) string {
+	i, ok := r.columns[normalizeHeader(name)]
+	if !ok {
+		return ""
+	}
+	if i >= len(r.record) {
+		r.fail(name, "", fmt.Sprintf("row has %d fields but the column is field %d", len(r.record), i+1), nil)
+		return ""
+	}
+	return r.record[i]
+}
+
+// string returns the trimmed value of the named column.  A blank value is recorded as a ParseError when the column is
+// required.
+func (r *row) string(name string) string {
+	return parseString(r.requiredValue(name))
+}
+
+// int returns the value of the named column as an int.  An empty value is 0 unless the column is required, and any
+// other value that is not a number is recorded as a ParseError.
+func (r *row) int(name string) int {
+	value := r.requiredValue(name)
+	i, err := parseInt(value)
+	if err != nil {
+		r.fail(name, value, "not a number", err)
+	}
+	return i
+}
+
+// int64 returns the value of the named column as an int64, as int does.
+func (r *row) int64(name string) int64 {
+	value := r.requiredValue(name)
+	i, err := parseInt64(value)
+	if err != nil {
+		r.fail(name, value, "not a number", err)
+	}
+	return i
+}
+
+// requiredValue returns the raw value of the named column, recording a ParseError when the column is required and its
+// value is blank.
+func (r *row) requiredValue(name string) string {
+	value := r.value(name)
+	if parseString(value) == "" && slices.ContainsFunc(r.required, func(required string) bool {
+		return normalizeHeader(required) == normalizeHeader(name)
+	}) {
+		r.fail(name, "", "required value is blank", nil)
+	}
+	return value
+}
+
+// fail records a ParseError for the row unless one has already been recorded.
+func (r *row) fail(column string, value string, reason string, err error) {
+	if r.err != nil {
+		return
+	}
+	r.err = &ParseError{Line: r.line, Column: column, Value: value, Reason: reason, Err: err}
+}
+
+func normalizeHeader(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,55 @@
+package roster
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConversionError describes a value which prevented a Scoutbook user from being converted, along with the member it
+// belongs to.
+type ConversionError struct {
+	BsaId int64
+	// Name is the member's first and last name.
+	Name string
+	// Field is the header of the roster column holding the bad value.
+	Field string
+	// Value is the raw value found in the roster.
+	Value string
+	// Err describes what is wrong with the value.
+	Err error
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("member %d (%s): %s %q: %v", e.BsaId, e.Name, e.Field, e.Value, e.Err)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// ConversionReport lists what went wrong converting a whole roster.  Errors are the members which were left out, and
+// Warnings are values which were replaced with an unknown value so the member could be kept.
+type ConversionReport struct {
+	Errors   []*ConversionError
+	Warnings []*ConversionError
+}
+
+var unexpectedYouthTrainingExpirationError = errors.New("training expiration date is not expected. Scoutbook has started sending expiration dates for youth training")
+
+func newConversionError(bsaId int64, firstName string, lastName string, field string, value string, err error) *ConversionError {
+	return &ConversionError{
+		BsaId: bsaId,
+		Name:  firstName + " " + lastName,
+		Field: field,
+		Value: value,
+		Err:   err,
+	}
+}
+
+func (u *AdultScoutbookUser) conversionError(field string, value string, err error) *ConversionError {
+	return newConversionError(u.BsaId, u.FirstName, u.LastName, field, value, err)
+}
+
+func (u *YouthScoutbookUser) conversionError(field string, value string, err error) *ConversionError {
+	return newConversionError(u.BsaId, u.FirstName, u.LastName, field, value, err)
+}
@@ -0,0 +1,77 @@
+package roster
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Course is a training course offered by the BSA, identified by the code Scoutbook puts in front of its title.
+type Course struct {
+	Code  string
+	Title string
+	// ValidMonths is how long the training stays current after it is taken, or 0 if it never expires.
+	ValidMonths int
+}
+
+// Expires reports whether the training has to be retaken.
+func (c Course) Expires() bool {
+	return c.ValidMonths > 0
+}
+
+// Scoutbook writes training as the course code followed by its title, as in "SCO_800 Hazardous Weather Training"
+var coursePattern = regexp.MustCompile(`^(?P<code>[A-Za-z]+_?\d+[A-Za-z]*)\s+(?P<title>.+)$`)
+
+// ParseCourseName splits a training name as written by Scoutbook into its course code and title.  The code is empty
+// when the name does not start with one.
+func ParseCourseName(value string) (code string, title string) {
+	value = strings.TrimSpace(value)
+	matches := coursePattern.FindStringSubmatch(value)
+	if matches == nil {
+		return "", value
+	}
+	return strings.ToUpper(matches[coursePattern.SubexpIndex("code")]), matches[coursePattern.SubexpIndex("title")]
+}
+
+// CourseCatalog holds the courses known by their code.  Codes are matched without regard to case.
+type CourseCatalog struct {
+	courses map[string]Course
+}
+
+// NewCourseCatalog returns a catalog of the training courses known to this package.  Units can add other courses
+// with Add.
+func NewCourseCatalog() *CourseCatalog {
+	catalog := &CourseCatalog{courses: map[string]Course{}}
+	for _, course := range builtInCourses {
+		catalog.Add(course)
+	}
+	return catalog
+}
+
+// DefaultCourseCatalog is the catalog used when converting Scoutbook users and when a nil catalog is given.
+var DefaultCourseCatalog = NewCourseCatalog()
+
+// Add puts the course in the catalog, replacing any course with the same code.
+func (c *CourseCatalog) Add(course Course) {
+	course.Code = strings.ToUpper(strings.TrimSpace(course.Code))
+	c.courses[course.Code] = course
+}
+
+// Course returns the course with the given code.
+func (c *CourseCatalog) Course(code string) (Course, bool) {
+	course, ok := c.courses[strings.ToUpper(strings.TrimSpace(code))]
+	return course, ok
+}
+
+func courseCatalogOrDefault(catalog *CourseCatalog) *CourseCatalog {
+	if catalog == nil {
+		return DefaultCourseCatalog
+	}
+	return catalog
+}
+
+var builtInCourses = []Course{
+	{Code: "Y01", Title: "Safeguarding Youth Training Certification", ValidMonths: 24},
+	{Code: "SCO_800", Title: "Hazardous Weather Training", ValidMonths: 24},
+	{Code: "S11", Title: "Introduction to Outdoor Leader Skills"},
+	{Code: "S24", Title: "Scoutmaster and Assistant Scoutmaster Leader Specific Training"},
+}
@@ -0,0 +1,73 @@
+package roster
+
+import (
+	"github.com/quincy/scoutbook-tools/date"
+	"testing"
+	"time"
+)
+
+func Test_ParseCourseNameSplitsCodeAndTitle(t *testing.T) {
+	testCases := []struct {
+		value         string
+		expectedCode  string
+		expectedTitle string
+	}{
+		{"Y01 Youth Protection Training Certification", "Y01", "Youth Protection Training Certification"},
+		{"SCO_800 Hazardous Weather Training", "SCO_800", "Hazardous Weather Training"},
+		{" Y01 Safeguarding Youth Training Certification ", "Y01", "Safeguarding Youth Training Certification"},
+		{"Swim Instructor", "", "Swim Instructor"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			// When I parse the training name
+			code, title := ParseCourseName(tc.value)
+
+			// Then the course code and title are separated
+			if code != tc.expectedCode || title != tc.expectedTitle {
+				t.Fatalf("Expected %q %q got %q %q", tc.expectedCode, tc.expectedTitle, code, title)
+			}
+		})
+	}
+}
+
+func Test_HasCurrentTrainingMatchesOnCourseCode(t *testing.T) {
+	// Given an adult whose Y01 was renamed by Scoutbook and who has a course which never expires
+	adult := AdultUser{Training: []UserStatusRecord{
+		TrainingRecord("Y01 Safeguarding Youth Training Certification", date.NewDate(2027, time.March, 3)),
+		TrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2025, time.June, 7)),
+		TrainingRecord("S24 Scoutmaster and Assistant Scoutmaster Leader Specific Training", date.Date{}),
+		TrainingRecord("D70 Unit Specific Course", date.Date{}),
+	}}
+	asOf := date.NewDate(2026, time.January, 1)
+
+	// And a unit whose catalog has a course which never expires
+	unitCatalog := NewCourseCatalog()
+	unitCatalog.Add(Course{Code: "d70", Title: "Unit Specific Course"})
+
+	testCases := []struct {
+		code     string
+		catalog  *CourseCatalog
+		expected bool
+	}{
+		{"Y01", nil, true},
+		{"y01", nil, true},
+		{"SCO_800", nil, false},
+		{"S24", nil, true},
+		{"D70", nil, false},
+		{"D70", unitCatalog, true},
+		{"C42", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code, func(t *testing.T) {
+			// When I ask whether the adult has current training in the course
+			actual := adult.HasCurrentTraining(tc.code, date.FixedClock(asOf), tc.catalog)
+
+			// Then only unexpired training in that course counts
+			if actual != tc.expected {
+				t.Fatalf("Expected %v got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package roster
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FormatVersion identifies a dialect of the Scoutbook roster export.
+type FormatVersion string
+
+const (
+	// OriginalFormat is the unquoted export, with the title row padded to the width of the header row.
+	OriginalFormat FormatVersion = "original"
+	// V20250626Format is the export Scoutbook started producing on 2025-06-26, with every cell quoted and blank
+	// cells written as " ".
+	V20250626Format FormatVersion = "v20250626"
+	// XlsxFormat is an Excel workbook export.  Workbooks are recognized by their container rather than by the
+	// registry.
+	XlsxFormat FormatVersion = "xlsx"
+)
+
+// FormatSample holds the title and header rows of a roster, both as raw text and split into fields, so a Format can
+// recognize them.
+type FormatSample struct {
+	TitleLine  string
+	HeaderLine string
+	Title      []string
+	Header     []string
+}
+
+// Format describes a dialect of the Scoutbook roster export.
+type Format struct {
+	Version FormatVersion
+	// Detect reports whether the sample is in this format.
+	Detect func(sample FormatSample) bool
+}
+
+var UnsupportedFormatError = errors.New("unsupported roster format")
+
+var quotedCells = regexp.MustCompile(`^\s*"[^"]*"(?:,"[^"]*")*\s*$`)
+
+var formats = []Format{
+	{
+		Version: OriginalFormat,
+		Detect: func(sample FormatSample) bool {
+			return !strings.Contains(sample.TitleLine, `"`) &&
+				!strings.Contains(sample.HeaderLine, `"`) &&
+				len(sample.Header) > 0 &&
+				len(sample.Title) == len(sample.Header) &&
+				strings.TrimSpace(sample.Header[0]) == ""
+		},
+	},
+	{
+		Version: V20250626Format,
+		Detect: func(sample FormatSample) bool {
+			return quotedCells.MatchString(sample.TitleLine) &&
+				quotedCells.MatchString(sample.HeaderLine) &&
+				len(sample.Title) == 2 &&
+				len(sample.Header) > 0 &&
+				parseString(strings.Trim(parseString(sample.Header[0]), `"`)) == ""
+		},
+	},
+}
+
+// RegisterFormat adds a format to the registry used to detect the format of a roster.  Formats are tried in the order
+// they were registered, after the built-in formats.
+func RegisterFormat(format Format) {
+	formats = append(formats, format)
+}
+
+// DetectFormat returns the version of the first registered format which recognizes the sample.  An
+// UnsupportedFormatError is returned when no format recognizes it.
+func DetectFormat(sample FormatSample) (FormatVersion, error) {
+	for _, format := range formats {
+		if format.Detect(sample) {
+			return format.Version, nil
+		}
+	}
+	return "", fmt.Errorf("%w: header row is %q", UnsupportedFormatError, strings.TrimSpace(sample.HeaderLine))
+}
@@ -0,0 +1,58 @@
+package roster
+
+import (
+	"errors"
+	"strings"
+)
+
+// Gender is a member's gender as recorded in Scoutbook.
+type Gender int
+
+const (
+	// UnknownGender is the gender of a member whose roster row leaves it blank or holds a value Scoutbook does not use.
+	UnknownGender Gender = iota
+	Male
+	Female
+)
+
+func (g Gender) String() string {
+	switch g {
+	case Male:
+		return "Male"
+	case Female:
+		return "Female"
+	default:
+		return "Unknown"
+	}
+}
+
+var genderNames = map[Gender]string{
+	UnknownGender: "unknown",
+	Male:          "male",
+	Female:        "female",
+}
+
+func (g Gender) MarshalText() ([]byte, error) {
+	return marshalName(genderNames, g)
+}
+
+func (g *Gender) UnmarshalText(text []byte) error {
+	return unmarshalName(genderNames, text, g)
+}
+
+var unknownGenderError = errors.New("unrecognized gender")
+
+// ParseGender reads a gender as written by Scoutbook, "M" or "F", or spelled out.  A blank value is UnknownGender and
+// any other value is an error.
+func ParseGender(value string) (Gender, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return UnknownGender, nil
+	case "m", "male":
+		return Male, nil
+	case "f", "female":
+		return Female, nil
+	default:
+		return UnknownGender, unknownGenderError
+	}
+}
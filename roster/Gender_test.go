@@ -0,0 +1,41 @@
+package roster
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ParseGenderNormalizesScoutbookValues(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected Gender
+	}{
+		{"M", Male},
+		{"F", Female},
+		{" female ", Female},
+		{"Male", Male},
+		{"", UnknownGender},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			// When I parse the gender
+			actual, err := ParseGender(tc.value)
+
+			// Then it is normalized
+			if err != nil || actual != tc.expected {
+				t.Fatalf("Expected %v got %v, %v", tc.expected, actual, err)
+			}
+		})
+	}
+}
+
+func Test_ParseGenderRejectsUnrecognizedValues(t *testing.T) {
+	// When I parse a value Scoutbook does not use
+	_, err := ParseGender("X")
+
+	// Then it is an error
+	if !errors.Is(err, unknownGenderError) {
+		t.Fatalf("Expected unknownGenderError got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package roster
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JsonVersion is the version of the JSON representation of converted users written by WriteJson.  It changes whenever
+// a change to the representation would break existing readers.
+const JsonVersion = 1
+
+// JsonSchema is the JSON Schema document describing the representation written by WriteJson.
+//
+//go:embed schema/users.v1.schema.json
+var JsonSchema string
+
+// JsonDocument is the versioned JSON representation of converted users.
+type JsonDocument struct {
+	Version int         `json:"version"`
+	Adults  []AdultUser `json:"adults"`
+	Youth   []YouthUser `json:"youth"`
+}
+
+// WriteJson writes the users to output as a JsonDocument of the current JsonVersion.
+func WriteJson(output io.Writer, adults []AdultUser, youth []YouthUser) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(JsonDocument{Version: JsonVersion, Adults: adults, Youth: youth})
+}
+
+// ReadJson reads a JsonDocument written by WriteJson.  A document of any other version is rejected with an
+// UnsupportedFormatError.
+func ReadJson(input io.Reader) (*JsonDocument, error) {
+	var document JsonDocument
+	if err := json.NewDecoder(input).Decode(&document); err != nil {
+		return nil, err
+	}
+	if document.Version != JsonVersion {
+		return nil, fmt.Errorf("%w: json version %d", UnsupportedFormatError, document.Version)
+	}
+	return &document, nil
+}
+
+// marshalName writes an enum by its name in names, which each enum declares beside its MarshalText, so that the JSON
+// representation does not depend on the order the values are declared in.
+func marshalName[T comparable](names map[T]string, value T) ([]byte, error) {
+	name, ok := names[value]
+	if !ok {
+		return nil, fmt.Errorf("no json name for %T %v", value, value)
+	}
+	return []byte(name), nil
+}
+
+// unmarshalName reads an enum written by marshalName.
+func unmarshalName[T comparable](names map[T]string, text []byte, value *T) error {
+	for v, name := range names {
+		if name == string(text) {
+			*value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown %T %q", *value, text)
+}
@@ -0,0 +1,236 @@
+package roster
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/quincy/scoutbook-tools/date"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func Test_JsonRoundTripsConvertedUsers(t *testing.T) {
+	// Given the converted users of the example rosters, with parents linked to the youth
+	adultRoster, err := NewCsvParser().ParseAdultRosterFile("test_resources/adult-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse adult roster: %v", err)
+	}
+	youthRoster, err := NewCsvParser().ParseYouthRosterFile("test_resources/youth-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse youth roster: %v", err)
+	}
+	parentRoster, err := NewCsvParser().ParseParentRosterFile("test_resources/parent-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse parent roster: %v", err)
+	}
+	adults, report := ToAdultUsers(adultRoster.Users, nil)
+	if report.Errors != nil {
+		t.Fatalf("Failed to convert adults: %v", report.Errors)
+	}
+	youth, report := ToYouthUsers(youthRoster.Users, nil)
+	if report.Errors != nil {
+		t.Fatalf("Failed to convert youth: %v", report.Errors)
+	}
+	LinkParents(youth, parentRoster.Users)
+
+	// When I write them as JSON and read them back
+	var buffer bytes.Buffer
+	if err := WriteJson(&buffer, adults, youth); err != nil {
+		t.Fatalf("Failed to write json: %v", err)
+	}
+	document, err := ReadJson(&buffer)
+	if err != nil {
+		t.Fatalf("Failed to read json: %v", err)
+	}
+
+	// Then the users are exactly the same
+	if document.Version != JsonVersion {
+		t.Fatalf("Expected version %d got %d", JsonVersion, document.Version)
+	}
+	if !reflect.DeepEqual(document.Adults, adults) {
+		t.Fatalf("Expected adults to be\n    %v\ngot %v", adults, document.Adults)
+	}
+	if !reflect.DeepEqual(document.Youth, youth) {
+		t.Fatalf("Expected youth to be\n    %v\ngot %v", youth, document.Youth)
+	}
+}
+
+func Test_JsonWritesEnumsByName(t *testing.T) {
+	// Given a swimmer
+	record := SwimmerRecord(date.Date{})
+
+	// When I write the record as JSON
+	data, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("Failed to write json: %v", err)
+	}
+
+	// Then the type and classification are written by name and unknown dates are null
+	expected := `{"type":"swim-class","name":"Swimmer","completionDate":null,"expirationDate":null,"expired":false,"classification":"swimmer"}`
+	if string(data) != expected {
+		t.Fatalf("Expected json to be\n    %s\ngot %s", expected, data)
+	}
+}
+
+func Test_ReadJsonRejectsOtherVersions(t *testing.T) {
+	// When I read a document of a version this package does not know
+	_, err := ReadJson(strings.NewReader(`{"version": 2, "adults": [], "youth": []}`))
+
+	// Then an UnsupportedFormatError is returned
+	if !errors.Is(err, UnsupportedFormatError) {
+		t.Fatalf("Expected UnsupportedFormatError got %v", err)
+	}
+}
+
+func Test_JsonSchemaIsValidJson(t *testing.T) {
+	// When I read the published schema
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(JsonSchema), &schema); err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+
+	// Then it describes the current version
+	properties := schema["properties"].(map[string]any)
+	version := properties["version"].(map[string]any)
+	if version["const"] != float64(JsonVersion) {
+		t.Fatalf("Expected the schema to describe version %d got %v", JsonVersion, version["const"])
+	}
+}
+
+func Test_JsonOutputMatchesTheSchema(t *testing.T) {
+	// Given the converted users of the example rosters, with parents linked to the youth
+	adultRoster, err := NewCsvParser().ParseAdultRosterFile("test_resources/adult-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse adult roster: %v", err)
+	}
+	youthRoster, err := NewCsvParser().ParseYouthRosterFile("test_resources/youth-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse youth roster: %v", err)
+	}
+	parentRoster, err := NewCsvParser().ParseParentRosterFile("test_resources/parent-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse parent roster: %v", err)
+	}
+	adults, _ := ToAdultUsers(adultRoster.Users, nil)
+	youth, _ := ToYouthUsers(youthRoster.Users, nil)
+	LinkParents(youth, parentRoster.Users)
+
+	// When I write them as JSON
+	var buffer bytes.Buffer
+	if err := WriteJson(&buffer, adults, youth); err != nil {
+		t.Fatalf("Failed to write json: %v", err)
+	}
+
+	// Then the document is valid against the published schema
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(JsonSchema), &schema); err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+	decoder := json.NewDecoder(&buffer)
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		t.Fatalf("Failed to read json: %v", err)
+	}
+	if err := validateSchema(schema, schema, document, "$"); err != nil {
+		t.Fatalf("Expected the json to match the schema: %v", err)
+	}
+}
+
+// validateSchema checks value against the subset of JSON Schema used by schema/users.v1.schema.json.  Any other
+// keyword is an error, so the schema cannot grow a rule this test silently ignores.
+func validateSchema(root map[string]any, schema map[string]any, value any, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		def, ok := root["$defs"].(map[string]any)[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: unknown $ref %s", path, ref)
+		}
+		if err := validateSchema(root, def, value, path); err != nil {
+			return err
+		}
+	}
+
+	for keyword, rule := range schema {
+		switch keyword {
+		case "$schema", "$id", "$defs", "$ref", "title", "description", "required", "additionalProperties":
+		case "type":
+			types, ok := rule.([]any)
+			if !ok {
+				types = []any{rule}
+			}
+			if !slices.ContainsFunc(types, func(t any) bool { return jsonTypeMatches(t.(string), value) }) {
+				return fmt.Errorf("%s: %v is not of type %v", path, value, rule)
+			}
+		case "const":
+			if fmt.Sprint(value) != fmt.Sprint(rule) {
+				return fmt.Errorf("%s: %v is not %v", path, value, rule)
+			}
+		case "enum":
+			if !slices.Contains(rule.([]any), value) {
+				return fmt.Errorf("%s: %v is not one of %v", path, value, rule)
+			}
+		case "pattern":
+			if text, ok := value.(string); ok && !regexp.MustCompile(rule.(string)).MatchString(text) {
+				return fmt.Errorf("%s: %q does not match %s", path, text, rule)
+			}
+		case "items":
+			items, _ := value.([]any)
+			for i, item := range items {
+				if err := validateSchema(root, rule.(map[string]any), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		case "properties":
+			object, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			properties := rule.(map[string]any)
+			for _, name := range schema["required"].([]any) {
+				if _, ok := object[name.(string)]; !ok {
+					return fmt.Errorf("%s: missing required property %s", path, name)
+				}
+			}
+			for name, property := range object {
+				propertySchema, ok := properties[name].(map[string]any)
+				if !ok {
+					if schema["additionalProperties"] == false {
+						return fmt.Errorf("%s: unexpected property %s", path, name)
+					}
+					continue
+				}
+				if err := validateSchema(root, propertySchema, property, path+"."+name); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("%s: unsupported schema keyword %s", path, keyword)
+		}
+	}
+	return nil
+}
+
+// jsonTypeMatches reports whether a value decoded with UseNumber is of the named JSON Schema type.
+func jsonTypeMatches(name string, value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return name == "null"
+	case bool:
+		return name == "boolean"
+	case string:
+		return name == "string"
+	case []any:
+		return name == "array"
+	case map[string]any:
+		return name == "object"
+	case json.Number:
+		_, err := v.Int64()
+		return name == "number" || name == "integer" && err == nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,77 @@
+package roster
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// NameFormat is a way of writing a member's name, chosen by each export or report.
+type NameFormat int
+
+const (
+	// FullName is "Alice Ames".
+	FullName NameFormat = iota
+	// InitialLastName is "A. Ames", which is how youth are named in reports shared outside the unit.
+	InitialLastName
+	// LastFirstName is "Ames, Alice", for lists sorted by family.
+	LastFirstName
+	// FirstNameOnly is "Alice".
+	FirstNameOnly
+)
+
+var nameFormatNames = map[NameFormat]string{
+	FullName:        "full",
+	InitialLastName: "initial-last",
+	LastFirstName:   "last-first",
+	FirstNameOnly:   "first-only",
+}
+
+func (f NameFormat) String() string {
+	if name, ok := nameFormatNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("NameFormat(%d)", int(f))
+}
+
+// ParseNameFormat returns the NameFormat whose String is the given value, such as "initial-last".
+func ParseNameFormat(value string) (NameFormat, error) {
+	for format, name := range nameFormatNames {
+		if strings.EqualFold(strings.TrimSpace(value), name) {
+			return format, nil
+		}
+	}
+	return FullName, fmt.Errorf("unknown name format %q", value)
+}
+
+// Format writes the name in this format.  Missing first or last names are left out rather than leaving stray spaces
+// or punctuation behind.
+func (f NameFormat) Format(firstName string, lastName string) string {
+	firstName = strings.TrimSpace(firstName)
+	lastName = strings.TrimSpace(lastName)
+
+	switch f {
+	case InitialLastName:
+		if firstName == "" {
+			return lastName
+		}
+		initial, _ := utf8.DecodeRuneInString(firstName)
+		return joinNames(" ", string(initial)+".", lastName)
+	case LastFirstName:
+		return joinNames(", ", lastName, firstName)
+	case FirstNameOnly:
+		return firstName
+	default:
+		return joinNames(" ", firstName, lastName)
+	}
+}
+
+func joinNames(separator string, names ...string) string {
+	var present []string
+	for _, name := range names {
+		if name != "" {
+			present = append(present, name)
+		}
+	}
+	return strings.Join(present, separator)
+}
@@ -0,0 +1,53 @@
+package roster
+
+import (
+	"testing"
+)
+
+func Test_NameFormatFormatsNames(t *testing.T) {
+	testCases := []struct {
+		format    NameFormat
+		firstName string
+		lastName  string
+		expected  string
+	}{
+		{FullName, "Alice", "Ames", "Alice Ames"},
+		{InitialLastName, "Alice", "Ames", "A. Ames"},
+		{LastFirstName, "Alice", "Ames", "Ames, Alice"},
+		{FirstNameOnly, "Alice", "Ames", "Alice"},
+		{FullName, "", "Ames", "Ames"},
+		{InitialLastName, "", "Ames", "Ames"},
+		{InitialLastName, "Émile", "Ames", "É. Ames"},
+		{LastFirstName, "Alice", "", "Alice"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format.String()+"/"+tc.firstName+" "+tc.lastName, func(t *testing.T) {
+			// When I format the name
+			actual := tc.format.Format(tc.firstName, tc.lastName)
+
+			// Then it is written in that format
+			if actual != tc.expected {
+				t.Fatalf("Expected name to be %q got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_ParseNameFormatReadsFormatNames(t *testing.T) {
+	// Given the name of each format
+	for _, expected := range []NameFormat{FullName, InitialLastName, LastFirstName, FirstNameOnly} {
+		// When I parse the name
+		actual, err := ParseNameFormat(expected.String())
+
+		// Then the format is returned
+		if err != nil || actual != expected {
+			t.Fatalf("Expected %v got %v, %v", expected, actual, err)
+		}
+	}
+
+	// And an unknown name is an error
+	if _, err := ParseNameFormat("nickname"); err == nil {
+		t.Fatalf("Expected an error for an unknown name format")
+	}
+}
@@ -0,0 +1,55 @@
+package roster
+
+import (
+	"fmt"
+)
+
+// ParseError describes a roster row which could not be parsed.
+type ParseError struct {
+	// Line is the line number in the roster where the problem was found, starting at 1.  For Excel workbooks it is the
+	// row number in the sheet.
+	Line int
+	// Column is the header of the column holding the bad value, or empty when the problem is with the row as a whole.
+	Column string
+	// Value is the raw value found in the roster.
+	Value string
+	// Reason describes what is wrong with the value.
+	Reason string
+	// Err is the underlying error, if there is one.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+	}
+	if e.Value == "" {
+		return fmt.Sprintf("line %d, column %q: %s", e.Line, e.Column, e.Reason)
+	}
+	return fmt.Sprintf("line %d, column %q: %s: %q", e.Line, e.Column, e.Reason, e.Value)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Mode controls how a Parser handles rows which cannot be parsed.
+type Mode int
+
+const (
+	// Strict stops parsing at the first bad row.
+	Strict Mode = iota
+	// Lenient skips bad rows, collecting a ParseError for each one.
+	Lenient
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Strict:
+		return "Strict"
+	case Lenient:
+		return "Lenient"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,133 @@
+package roster
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Position is a position of responsibility held by a member, such as "Patrol Leader" of the Vikings patrol.
+type Position struct {
+	Title string `json:"title"`
+	// Subunit is the name of the patrol or den the position applies to, or empty when it applies to the whole unit.
+	Subunit     string      `json:"subunit"`
+	SubunitType SubunitType `json:"subunitType"`
+	Unit        string      `json:"unit"`
+}
+
+// SubunitType is the kind of group within a unit that a position applies to.
+type SubunitType int
+
+const (
+	NoSubunit SubunitType = iota
+	PatrolSubunit
+	DenSubunit
+)
+
+func (st SubunitType) String() string {
+	switch st {
+	case PatrolSubunit:
+		return "Patrol"
+	case DenSubunit:
+		return "Den"
+	default:
+		return ""
+	}
+}
+
+// A position for the whole unit has no subunit type, which is written as an empty name.
+var subunitTypeNames = map[SubunitType]string{
+	NoSubunit:     "",
+	PatrolSubunit: "patrol",
+	DenSubunit:    "den",
+}
+
+func (st SubunitType) MarshalText() ([]byte, error) {
+	return marshalName(subunitTypeNames, st)
+}
+
+func (st *SubunitType) UnmarshalText(text []byte) error {
+	return unmarshalName(subunitTypeNames, text, st)
+}
+
+func parseSubunitType(value string) SubunitType {
+	switch {
+	case strings.EqualFold(value, PatrolSubunit.String()):
+		return PatrolSubunit
+	case strings.EqualFold(value, DenSubunit.String()):
+		return DenSubunit
+	default:
+		return NoSubunit
+	}
+}
+
+// Scoutbook writes positions held in a subunit as "Patrol Leader [ Vikings] Patrol"
+var positionPattern = regexp.MustCompile(`^(?P<title>.*?)\s*\[\s*(?P<subunit>[^]]*?)\s*]\s*(?P<type>\w*)\s*$`)
+
+// ParsePosition parses a single position as written by Scoutbook.  The unit is the unit the member holds the position
+// in, which Scoutbook reports separately.
+func ParsePosition(value string, unit string) Position {
+	value = strings.TrimSpace(value)
+	matches := positionPattern.FindStringSubmatch(value)
+	if matches == nil {
+		return Position{Title: value, Unit: unit}
+	}
+
+	subunit := matches[positionPattern.SubexpIndex("subunit")]
+	subunitType := parseSubunitType(matches[positionPattern.SubexpIndex("type")])
+	if subunitType == NoSubunit && subunit != "" {
+		subunitType = PatrolSubunit
+	}
+
+	return Position{
+		Title:       matches[positionPattern.SubexpIndex("title")],
+		Subunit:     subunit,
+		SubunitType: subunitType,
+		Unit:        unit,
+	}
+}
+
+// parsePositions parses the pipe separated list of positions exported by Scoutbook.  Positions listed more than once
+// are only returned once, in the order they were first listed.
+func parsePositions(positions string, unit string) []Position {
+	parsed := []Position{}
+	if strings.TrimSpace(positions) == "" {
+		return parsed
+	}
+
+	for _, value := range paddedPipePattern.Split(positions, noLimit) {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		position := ParsePosition(value, unit)
+		if !slices.ContainsFunc(parsed, position.sameAs) {
+			parsed = append(parsed, position)
+		}
+	}
+
+	return parsed
+}
+
+// Is reports whether the position has the given title and applies to the given patrol or den.  Titles and subunits
+// are compared without regard to case, and an empty subunit matches any subunit.
+func (p Position) Is(title string, subunit string) bool {
+	return strings.EqualFold(p.Title, strings.TrimSpace(title)) &&
+		(subunit == "" || strings.EqualFold(p.Subunit, strings.TrimSpace(subunit)))
+}
+
+// sameAs reports whether two positions are the same, without regard to case.
+func (p Position) sameAs(other Position) bool {
+	return strings.EqualFold(p.Title, other.Title) &&
+		strings.EqualFold(p.Subunit, other.Subunit) &&
+		p.SubunitType == other.SubunitType &&
+		strings.EqualFold(p.Unit, other.Unit)
+}
+
+// String returns the position the way Scoutbook writes it.
+func (p Position) String() string {
+	if p.Subunit == "" {
+		return p.Title
+	}
+	return p.Title + " [" + p.Subunit + "] " + p.SubunitType.String()
+}
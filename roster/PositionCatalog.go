@@ -0,0 +1,147 @@
+package roster
+
+import (
+	"strings"
+)
+
+// PositionCategory groups positions by the role they play in the unit.  A position can belong to more than one
+// category, so categories are bit flags which can be combined with |.
+type PositionCategory uint
+
+const (
+	// Key3 positions are the Chartered Organization Representative, the Committee Chair and the unit leader.
+	Key3 PositionCategory = 1 << iota
+	// Committee positions serve on the unit committee.
+	Committee
+	// DirectContact positions work directly with youth.
+	DirectContact
+	// Reserve positions are registered adults who are not active in the unit.
+	Reserve
+	// YouthPositionOfResponsibility positions are youth leadership positions.
+	YouthPositionOfResponsibility
+)
+
+func (c PositionCategory) String() string {
+	var names []string
+	for _, category := range []PositionCategory{Key3, Committee, DirectContact, Reserve, YouthPositionOfResponsibility} {
+		if c&category == 0 {
+			continue
+		}
+		switch category {
+		case Key3:
+			names = append(names, "Key 3")
+		case Committee:
+			names = append(names, "Committee")
+		case DirectContact:
+			names = append(names, "Direct Contact")
+		case Reserve:
+			names = append(names, "Reserve")
+		case YouthPositionOfResponsibility:
+			names = append(names, "Youth Position of Responsibility")
+		}
+	}
+	if len(names) == 0 {
+		return "None"
+	}
+	return strings.Join(names, " | ")
+}
+
+// PositionCatalog classifies position titles into categories.  Titles are matched without regard to case.
+type PositionCatalog struct {
+	categories map[string]PositionCategory
+}
+
+// NewPositionCatalog returns a catalog of the Scoutbook position titles known to this package.  Units can add their
+// own titles with Add.
+func NewPositionCatalog() *PositionCatalog {
+	catalog := &PositionCatalog{categories: map[string]PositionCategory{}}
+	for title, categories := range builtInPositions {
+		catalog.Add(title, categories)
+	}
+	return catalog
+}
+
+// DefaultPositionCatalog is the catalog used when a nil catalog is given.
+var DefaultPositionCatalog = NewPositionCatalog()
+
+// Add classifies the title into the given categories, in addition to any categories it is already in.
+func (c *PositionCatalog) Add(title string, categories PositionCategory) {
+	key := normalizeTitle(title)
+	c.categories[key] = c.categories[key] | categories
+}
+
+// Categories returns the categories the title belongs to, or 0 if the title is not in the catalog.
+func (c *PositionCatalog) Categories(title string) PositionCategory {
+	return c.categories[normalizeTitle(title)]
+}
+
+// Is reports whether the position belongs to any of the given categories.
+func (c *PositionCatalog) Is(position Position, categories PositionCategory) bool {
+	return c.Categories(position.Title)&categories != 0
+}
+
+func normalizeTitle(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
+
+func catalogOrDefault(catalog *PositionCatalog) *PositionCatalog {
+	if catalog == nil {
+		return DefaultPositionCatalog
+	}
+	return catalog
+}
+
+var builtInPositions = map[string]PositionCategory{
+	// Adult leaders
+	"Scoutmaster":                           Key3 | DirectContact,
+	"Assistant Scoutmaster":                 DirectContact,
+	"Cubmaster":                             Key3 | DirectContact,
+	"Assistant Cubmaster":                   DirectContact,
+	"Den Leader":                            DirectContact,
+	"Assistant Den Leader":                  DirectContact,
+	"Lion Guide":                            DirectContact,
+	"Venturing Crew Advisor":                Key3 | DirectContact,
+	"Venturing Crew Associate Advisor":      DirectContact,
+	"Skipper":                               Key3 | DirectContact,
+	"Mate":                                  DirectContact,
+	"Chartered Organization Rep.":           Key3,
+	"Chartered Organization Representative": Key3,
+
+	// Unit committee
+	"Committee Chairman":               Key3 | Committee,
+	"Committee Chair":                  Key3 | Committee,
+	"Committee Member":                 Committee,
+	"Unit Advancement Chair":           Committee,
+	"Unit Treasurer":                   Committee,
+	"Unit Secretary":                   Committee,
+	"Unit Outdoors / Activities Chair": Committee,
+	"Unit Training Chair":              Committee,
+	"Unit Membership Chair":            Committee,
+	"Unit Fundraising Chair":           Committee,
+	"Unit Public Relations Chair":      Committee,
+	"Unit Equipment Coordinator":       Committee,
+	"Life-to-Eagle Coordinator":        Committee,
+	"Committee Membership Coordinator": Committee,
+
+	// Reserves
+	"Unit Scouter Reserve":         Reserve,
+	"Unit College Scouter Reserve": Reserve,
+
+	// Youth positions of responsibility
+	"Senior Patrol Leader":           YouthPositionOfResponsibility,
+	"Assistant Senior Patrol Leader": YouthPositionOfResponsibility,
+	"Patrol Leader":                  YouthPositionOfResponsibility,
+	"Troop Guide":                    YouthPositionOfResponsibility,
+	"Den Chief":                      YouthPositionOfResponsibility,
+	"Junior Assistant Scoutmaster":   YouthPositionOfResponsibility,
+	"Scribe":                         YouthPositionOfResponsibility,
+	"Quartermaster":                  YouthPositionOfResponsibility,
+	"Historian":                      YouthPositionOfResponsibility,
+	"Librarian":                      YouthPositionOfResponsibility,
+	"Instructor":                     YouthPositionOfResponsibility,
+	"Chaplain Aide":                  YouthPositionOfResponsibility,
+	"Bugler":                         YouthPositionOfResponsibility,
+	"OA Unit Representative":         YouthPositionOfResponsibility,
+	"Outdoor Ethics Guide":           YouthPositionOfResponsibility,
+	"Webmaster":                      YouthPositionOfResponsibility,
+}
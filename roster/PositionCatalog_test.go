@@ -0,0 +1,78 @@
+package roster
+
+import (
+	"github.com/quincy/scoutbook-tools/assertions"
+	"testing"
+)
+
+func Test_PositionCatalogFiltersAdultsByCategory(t *testing.T) {
+	// Given the converted adult roster
+	adultRoster, err := NewCsvParser().ParseAdultRosterFile("test_resources/adult-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+	var adults []AdultUser
+	for _, user := range adultRoster.Users {
+		adult, _, err := user.ToAdultUser(nil)
+		if err != nil {
+			t.Fatalf("Failed to convert AdultScoutbookUser to AdultUser: %v", err)
+		}
+		adults = append(adults, adult)
+	}
+
+	// And a unit which counts its Executive Officer as committee
+	unitCatalog := NewPositionCatalog()
+	unitCatalog.Add("executive officer", Committee)
+
+	testCases := []struct {
+		name       string
+		catalog    *PositionCatalog
+		categories PositionCategory
+		expected   []int64
+	}{
+		{name: "Key 3", categories: Key3, expected: []int64{3, 8, 12}},
+		{name: "Direct contact", categories: DirectContact, expected: []int64{2, 5, 8, 10}},
+		{name: "Committee", categories: Committee, expected: []int64{1, 4, 5, 10, 11, 12, 13}},
+		{name: "Reserve", categories: Reserve, expected: []int64{6, 9}},
+		{name: "Key 3 or reserve", categories: Key3 | Reserve, expected: []int64{3, 6, 8, 9, 12}},
+		{name: "Unit specific title", catalog: unitCatalog, categories: Committee, expected: []int64{1, 4, 5, 7, 10, 11, 12, 13}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When I filter the adults by category
+			var actualIds []int64
+			for _, adult := range AdultsIn(adults, tc.catalog, tc.categories) {
+				actualIds = append(actualIds, adult.BsaId)
+			}
+
+			// Then only the adults holding a position in that category are returned
+			if !assertions.Collection[int64](actualIds).ContainsExactly(tc.expected) {
+				t.Fatalf("Expected BSA ids to be %v got %v", tc.expected, actualIds)
+			}
+		})
+	}
+}
+
+func Test_PositionCatalogFiltersYouthPositionsOfResponsibility(t *testing.T) {
+	// Given youth with and without positions of responsibility
+	youth := []YouthUser{
+		{BsaId: 100, Positions: parsePositions("Patrol Leader [ Vikings] Patrol | Scouts BSA [ Vikings] Patrol", "")},
+		{BsaId: 101, Positions: parsePositions("Scouts BSA [ Dreadnoughts] Patrol", "")},
+		{BsaId: 102, Positions: parsePositions("Chaplain Aide | Scouts BSA [ Warthogs] Patrol", "")},
+	}
+
+	// When I filter the youth who hold a position of responsibility
+	var actualIds []int64
+	for _, y := range YouthIn(youth, nil, YouthPositionOfResponsibility) {
+		actualIds = append(actualIds, y.BsaId)
+	}
+
+	// Then only those youth are returned
+	if !assertions.Collection[int64](actualIds).ContainsExactly([]int64{100, 102}) {
+		t.Fatalf("Expected BSA ids to be [100 102] got %v", actualIds)
+	}
+	if positions := youth[0].PositionsIn(nil, YouthPositionOfResponsibility); len(positions) != 1 || positions[0].Title != "Patrol Leader" {
+		t.Fatalf("Expected only the Patrol Leader position got %v", positions)
+	}
+}
@@ -0,0 +1,50 @@
+package roster
+
+import (
+	"testing"
+)
+
+func Test_ParsePositionReadsSubunit(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected Position
+	}{
+		{value: "Scoutmaster", expected: Position{Title: "Scoutmaster", Unit: "Troop 77 B"}},
+		{value: "Patrol Leader [ Vikings] Patrol", expected: Position{Title: "Patrol Leader", Subunit: "Vikings", SubunitType: PatrolSubunit, Unit: "Troop 77 B"}},
+		{value: " Den Leader [Den 3 ] Den ", expected: Position{Title: "Den Leader", Subunit: "Den 3", SubunitType: DenSubunit, Unit: "Troop 77 B"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			// When I parse the position
+			actual := ParsePosition(tc.value, "Troop 77 B")
+
+			// Then the title and subunit are separated
+			if actual != tc.expected {
+				t.Fatalf("Expected position to be %+v got %+v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_YouthUserHasPositionInPatrol(t *testing.T) {
+	// Given a youth who is the Patrol Leader of the Vikings
+	scoutbookUser := YouthScoutbookUser{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "07/04/2014", Positions: "Patrol Leader [ Vikings] Patrol | Scouts BSA [ Vikings] Patrol | Patrol Leader [ Vikings] Patrol"}
+	youth, _, err := scoutbookUser.ToYouthUser(nil)
+	if err != nil {
+		t.Fatalf("Could not convert YouthScoutbookUser to YouthUser: %v", err)
+	}
+
+	// Then the duplicate position is collapsed
+	if len(youth.Positions) != 2 {
+		t.Fatalf("Expected 2 positions got %v", youth.Positions)
+	}
+
+	// And the youth is only the Patrol Leader of the Vikings
+	if !youth.HasPosition("patrol leader", "VIKINGS") || !youth.HasPosition("Patrol Leader", "") {
+		t.Fatalf("Expected %v to be Patrol Leader of the Vikings", youth.Positions)
+	}
+	if youth.HasPosition("Patrol Leader", "Warthogs") {
+		t.Fatalf("Expected %v not to be Patrol Leader of the Warthogs", youth.Positions)
+	}
+}
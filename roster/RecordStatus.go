@@ -0,0 +1,167 @@
+package roster
+
+import (
+	"github.com/quincy/scoutbook-tools/date"
+)
+
+// Status is how a UserStatusRecord stands as of a given date.
+type Status int
+
+const (
+	// UnknownStatus is the status of a record without an expiration date, such as a Non-Swimmer, a swim test taken
+	// on an unknown date or training in a course which is not known to never expire.
+	UnknownStatus Status = iota
+	Current
+	ExpiringSoon
+	Expired
+	// NotYetValid is the status of a record completed after the start of the window it is evaluated over, such as a
+	// health form signed in the middle of a trip.
+	NotYetValid
+)
+
+func (s Status) String() string {
+	switch s {
+	case Current:
+		return "Current"
+	case ExpiringSoon:
+		return "Expiring Soon"
+	case Expired:
+		return "Expired"
+	case NotYetValid:
+		return "Not Yet Valid"
+	default:
+		return "Unknown"
+	}
+}
+
+// RecordStatus is the status of a record as of a given date or over a window of dates.
+type RecordStatus struct {
+	Record UserStatusRecord
+	Status Status
+	// DaysRemaining is the number of days from the as-of date, or the start of the window, until the record expires.
+	// For an Expired record it is negative: the number of days before the as-of date, or the end of the window, that
+	// the record expired.  It is 0 when the record has no expiration date.
+	DaysRemaining int
+}
+
+// StatusPolicy holds how many days before its expiration date each type of record is reported as ExpiringSoon, and the
+// course catalog which says which training never expires.
+type StatusPolicy struct {
+	warningDays map[RecordType]int
+	courses     *CourseCatalog
+}
+
+// NewStatusPolicy returns a policy with the default warning windows: 60 days for training and 30 days for health
+// forms and swim classes.  Units can change them with SetWarningDays.  The policy uses the DefaultCourseCatalog until
+// another is set with SetCourseCatalog.
+func NewStatusPolicy() *StatusPolicy {
+	return &StatusPolicy{warningDays: map[RecordType]int{
+		Training:   60,
+		HealthForm: 30,
+		SwimClass:  30,
+	}}
+}
+
+// DefaultStatusPolicy is the policy used when a nil policy is given.
+var DefaultStatusPolicy = NewStatusPolicy()
+
+// SetWarningDays sets how many days before expiring a record of the given type is reported as ExpiringSoon.
+func (p *StatusPolicy) SetWarningDays(recordType RecordType, days int) {
+	p.warningDays[recordType] = days
+}
+
+// WarningDays returns how many days before expiring a record of the given type is reported as ExpiringSoon.
+func (p *StatusPolicy) WarningDays(recordType RecordType) int {
+	return p.warningDays[recordType]
+}
+
+// SetCourseCatalog sets the catalog used to decide whether training without an expiration date never expires.
+func (p *StatusPolicy) SetCourseCatalog(catalog *CourseCatalog) {
+	p.courses = catalog
+}
+
+// CourseCatalog returns the catalog used to decide whether training without an expiration date never expires.
+func (p *StatusPolicy) CourseCatalog() *CourseCatalog {
+	return courseCatalogOrDefault(p.courses)
+}
+
+func clockOrSystem(clock date.Clock) date.Clock {
+	if clock == nil {
+		return date.SystemClock
+	}
+	return clock
+}
+
+func statusPolicyOrDefault(policy *StatusPolicy) *StatusPolicy {
+	if policy == nil {
+		return DefaultStatusPolicy
+	}
+	return policy
+}
+
+// Status returns the status of the record today, or on the clock's date when a clock is given, as StatusAsOf does.
+func (r UserStatusRecord) Status(clock date.Clock, policy *StatusPolicy) RecordStatus {
+	return r.StatusAsOf(clockOrSystem(clock).Today(), policy)
+}
+
+// StatusAsOf returns the status of the record as of the given date.  A record is valid through its expiration date.
+func (r UserStatusRecord) StatusAsOf(asOf date.Date, policy *StatusPolicy) RecordStatus {
+	return r.StatusDuring(dayOf(asOf), policy)
+}
+
+// dayOf returns the window holding only the given date.
+func dayOf(d date.Date) date.Range {
+	return date.Range{Start: d, End: d}
+}
+
+// StatusDuring returns the status of the record over a window of dates, such as a trip or a camp session.  A record
+// has to be valid on every day of the window: one completed after the window's Start is NotYetValid and one which
+// expires before the window's End is Expired.  Otherwise it is ExpiringSoon when it expires within the policy's
+// warning window of the window's Start.
+func (r UserStatusRecord) StatusDuring(window date.Range, policy *StatusPolicy) RecordStatus {
+	policy = statusPolicyOrDefault(policy)
+	if r.ExpirationDate.IsZero() {
+		course, known := policy.CourseCatalog().Course(r.Code)
+		if r.Type == Training && known && !course.Expires() {
+			return RecordStatus{Record: r, Status: Current}
+		}
+		return RecordStatus{Record: r, Status: UnknownStatus}
+	}
+
+	days := window.Start.DaysUntil(r.ExpirationDate)
+	switch {
+	case r.CompletionDate.After(window.Start):
+		return RecordStatus{Record: r, Status: NotYetValid, DaysRemaining: days}
+	case r.ExpirationDate.Before(window.End):
+		return RecordStatus{Record: r, Status: Expired, DaysRemaining: window.End.DaysUntil(r.ExpirationDate)}
+	case days <= policy.WarningDays(r.Type):
+		return RecordStatus{Record: r, Status: ExpiringSoon, DaysRemaining: days}
+	default:
+		return RecordStatus{Record: r, Status: Current, DaysRemaining: days}
+	}
+}
+
+// recordStatuses returns the status of each training, health form and swim class record over the window.
+func recordStatuses(training []UserStatusRecord, healthForms []UserStatusRecord, swimClass UserStatusRecord, window date.Range, policy *StatusPolicy) []RecordStatus {
+	var statuses []RecordStatus
+	for _, r := range training {
+		statuses = append(statuses, r.StatusDuring(window, policy))
+	}
+	for _, r := range healthForms {
+		statuses = append(statuses, r.StatusDuring(window, policy))
+	}
+	return append(statuses, swimClass.StatusDuring(window, policy))
+}
+
+// worstStatus returns NotYetValid if any record is not yet valid, otherwise Expired if any record has expired,
+// otherwise ExpiringSoon if any record is expiring soon, and otherwise Current.  Records with an UnknownStatus are
+// left out, and when no record has a known status the result is UnknownStatus.
+func worstStatus(statuses []RecordStatus) Status {
+	worst := UnknownStatus
+	for _, s := range statuses {
+		if s.Status > worst {
+			worst = s.Status
+		}
+	}
+	return worst
+}
@@ -0,0 +1,89 @@
+package roster
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RosterType identifies the kind of members held in a roster, as named by its title row.
+type RosterType int
+
+const (
+	UnknownRosterType RosterType = iota
+	AdultMembers
+	YouthMembers
+	ParentMembers
+)
+
+func (rt RosterType) String() string {
+	switch rt {
+	case AdultMembers:
+		return "ADULT MEMBERS"
+	case YouthMembers:
+		return "YOUTH MEMBERS"
+	case ParentMembers:
+		return "PARENT/GUARDIAN MEMBERS"
+	default:
+		return "Unknown"
+	}
+}
+
+// parseRosterType returns the RosterType named by the title of a roster.
+func parseRosterType(title string) RosterType {
+	for _, rt := range []RosterType{AdultMembers, YouthMembers, ParentMembers} {
+		if strings.EqualFold(strings.TrimSpace(title), rt.String()) {
+			return rt
+		}
+	}
+	return UnknownRosterType
+}
+
+// RosterTypeError is returned when a roster's title row does not name the type of roster that was expected, or does
+// not name a known type at all.
+type RosterTypeError struct {
+	// Expected is the type of roster that was asked for, or UnknownRosterType when any type would do.
+	Expected RosterType
+	// Title is the text of the roster's title row.
+	Title string
+}
+
+func (e *RosterTypeError) Error() string {
+	if e.Expected == UnknownRosterType {
+		return fmt.Sprintf("roster title %q is not a known roster type", e.Title)
+	}
+	return fmt.Sprintf("expected a roster titled %q but the title row is %q", e.Expected.String(), e.Title)
+}
+
+// Roster is the result of parsing a roster whose type was read from its title row.  Exactly one of Adult, Youth or
+// Parent is set, according to Type.
+type Roster struct {
+	Type   RosterType
+	Adult  *AdultRoster
+	Youth  *YouthRoster
+	Parent *ParentRoster
+}
+
+// AdultRoster is the result of parsing an adult roster.  Rows are only skipped, and listed in Errors, in Lenient mode.
+type AdultRoster struct {
+	// Format is the version of the Scoutbook export the roster was read from.
+	Format FormatVersion
+	// Users are the members which were parsed successfully.
+	Users []AdultScoutbookUser
+	// Errors describe the rows which were skipped because they could not be parsed.
+	Errors []*ParseError
+}
+
+// YouthRoster is the result of parsing a youth roster, with the same fields as an AdultRoster.
+type YouthRoster struct {
+	Format FormatVersion
+	Users  []YouthScoutbookUser
+	Errors []*ParseError
+}
+
+// ParentRoster is the result of parsing a parent/guardian roster, with the same fields as an AdultRoster.  A parent
+// with more than one youth in the unit appears in Users once for each youth.
+type ParentRoster struct {
+	Format FormatVersion
+	Users  []ParentScoutbookUser
+	Errors []*ParseError
+}
@@ -1,11 +1,16 @@
 package roster
 
 import (
+	"bufio"
 	"encoding/csv"
 	"errors"
 	"fmt"
 	"github.com/quincy/scoutbook-tools/date"
+	"io"
+	"iter"
+	"log"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -16,86 +21,473 @@ const paddedPipe = `\s*\|\s*`
 
 var paddedPipePattern = regexp.MustCompile(paddedPipe)
 
+// Parser reads Scoutbook roster exports.  The Stream methods yield each row as it is read: in Strict mode iteration
+// stops after the first error, and in Lenient mode a *ParseError is yielded for each bad row and iteration continues
+// with the next row.
 type Parser interface {
-	ParseAdultRoster(input *os.File) ([]AdultScoutbookUser, error)
-	ParseYouthRoster(input *os.File) ([]YouthScoutbookUser, error)
+	// Parse reads a roster of any type from input, using its title row to decide whether it holds adults, youth or
+	// parents and guardians.
+	Parse(input io.Reader) (*Roster, error)
+	// ParseFile reads a roster of any type from the file at path.
+	ParseFile(path string) (*Roster, error)
+	// ParseAdultRoster reads an adult roster from input.  A RosterTypeError is returned if the title row is not
+	// "ADULT MEMBERS".
+	ParseAdultRoster(input io.Reader) (*AdultRoster, error)
+	// ParseYouthRoster reads a youth roster from input.  A RosterTypeError is returned if the title row is not
+	// "YOUTH MEMBERS".
+	ParseYouthRoster(input io.Reader) (*YouthRoster, error)
+	// ParseParentRoster reads a parent/guardian roster from input.  A RosterTypeError is returned if the title row is
+	// not "PARENT/GUARDIAN MEMBERS".
+	ParseParentRoster(input io.Reader) (*ParentRoster, error)
+	// ParseAdultRosterFile reads an adult roster from the file at path.
+	ParseAdultRosterFile(path string) (*AdultRoster, error)
+	// ParseYouthRosterFile reads a youth roster from the file at path.
+	ParseYouthRosterFile(path string) (*YouthRoster, error)
+	// ParseParentRosterFile reads a parent/guardian roster from the file at path.
+	ParseParentRosterFile(path string) (*ParentRoster, error)
+	// StreamAdultRoster yields the members of an adult roster one at a time as they are read from input.
+	StreamAdultRoster(input io.Reader) iter.Seq2[AdultScoutbookUser, error]
+	// StreamYouthRoster yields the members of a youth roster one at a time as they are read from input.
+	StreamYouthRoster(input io.Reader) iter.Seq2[YouthScoutbookUser, error]
+	// StreamParentRoster yields the rows of a parent/guardian roster one at a time as they are read from input.
+	StreamParentRoster(input io.Reader) iter.Seq2[ParentScoutbookUser, error]
 }
 
-type csvParser struct{}
+// ParserOption configures a Parser.
+type ParserOption func(p *parser)
 
-func NewCsvParser() Parser {
-	return &csvParser{}
+// WithMode sets how the Parser handles rows which cannot be parsed.  The default is Strict.
+func WithMode(mode Mode) ParserOption {
+	return func(p *parser) {
+		p.mode = mode
+	}
 }
 
-func (p *csvParser) ParseAdultRoster(input *os.File) ([]AdultScoutbookUser, error) {
-	r := csv.NewReader(input)
-	r.LazyQuotes = true    // Allow quotes to appear in unquoted fields
-	r.FieldsPerRecord = -1 // Allow records with varying numbers of fields
-	records, err := r.ReadAll()
+// openFunc reads the title and header rows of a roster from input.  The expected roster type may be used to choose
+// between several rosters in the input.
+type openFunc func(input io.Reader, expected RosterType) (*rosterReader, error)
+
+type parser struct {
+	mode Mode
+	open openFunc
+}
+
+func newParser(open openFunc, options []ParserOption) Parser {
+	p := &parser{mode: Strict, open: open}
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// NewCsvParser returns a Parser for rosters exported from Scoutbook as CSV.
+func NewCsvParser(options ...ParserOption) Parser {
+	return newParser(newCsvRosterReader, options)
+}
+
+// NewParserForFile returns a Parser suited to the file at path, based on its extension.  Files ending in .xlsx are
+// read as Excel workbooks, and all other files as CSV.
+func NewParserForFile(path string, options ...ParserOption) Parser {
+	if strings.EqualFold(filepath.Ext(path), ".xlsx") {
+		return NewXlsxParser(options...)
+	}
+	return NewCsvParser(options...)
+}
+
+func (p *parser) Parse(input io.Reader) (*Roster, error) {
+	reader, err := p.open(input, UnknownRosterType)
 	if err != nil {
 		return nil, err
 	}
-	if len(records) <= 2 { // Check for header-only or empty file
-		return nil, EmptyRosterError
-	}
 
-	// Skip header rows (index 0 and 1) and process remaining records
-	var users []AdultScoutbookUser
-	for _, record := range records[2:] {
-		user := AdultScoutbookUser{
-			FirstName:           parseString(record[1]),
-			LastName:            parseString(record[2]),
-			Email:               parseString(record[3]),
-			Gender:              parseString(record[4]),
-			BsaId:               parseInt64(record[5]),
-			UnitNumber:          parseString(record[6]),
-			Training:            parseString(record[7]),
-			TrainingExpiration:  parseString(record[8]),
-			HealthForms:         parseString(record[9]),
-			SwimClass:           parseString(record[10]),
-			SwimClassExpiration: parseString(record[11]),
-			Positions:           parseString(record[12]),
+	switch reader.rosterType {
+	case AdultMembers:
+		adults, err := p.readAdultRoster(reader)
+		if err != nil {
+			return nil, err
 		}
-		users = append(users, user)
+		return &Roster{Type: AdultMembers, Adult: adults}, nil
+	case YouthMembers:
+		youth, err := p.readYouthRoster(reader)
+		if err != nil {
+			return nil, err
+		}
+		return &Roster{Type: YouthMembers, Youth: youth}, nil
+	case ParentMembers:
+		parents, err := p.readParentRoster(reader)
+		if err != nil {
+			return nil, err
+		}
+		return &Roster{Type: ParentMembers, Parent: parents}, nil
+	default:
+		return nil, &RosterTypeError{Title: reader.titleText()}
+	}
+}
+
+func (p *parser) ParseAdultRoster(input io.Reader) (*AdultRoster, error) {
+	reader, err := p.open(input, AdultMembers)
+	if err != nil {
+		return nil, err
+	}
+	if err := reader.expect(AdultMembers); err != nil {
+		return nil, err
+	}
+	return p.readAdultRoster(reader)
+}
+
+func (p *parser) ParseYouthRoster(input io.Reader) (*YouthRoster, error) {
+	reader, err := p.open(input, YouthMembers)
+	if err != nil {
+		return nil, err
+	}
+	if err := reader.expect(YouthMembers); err != nil {
+		return nil, err
+	}
+	return p.readYouthRoster(reader)
+}
+
+func (p *parser) ParseParentRoster(input io.Reader) (*ParentRoster, error) {
+	reader, err := p.open(input, ParentMembers)
+	if err != nil {
+		return nil, err
+	}
+	if err := reader.expect(ParentMembers); err != nil {
+		return nil, err
+	}
+	return p.readParentRoster(reader)
+}
+
+func (p *parser) readAdultRoster(reader *rosterReader) (*AdultRoster, error) {
+	users, rowErrors, err := collect(readRows(reader, p.mode, requiredAdultColumns, decodeAdultUser), p.mode)
+	if err != nil {
+		return nil, err
+	}
+	return &AdultRoster{Format: reader.format, Users: users, Errors: rowErrors}, nil
+}
+
+func (p *parser) readYouthRoster(reader *rosterReader) (*YouthRoster, error) {
+	users, rowErrors, err := collect(readRows(reader, p.mode, requiredYouthColumns, decodeYouthUser), p.mode)
+	if err != nil {
+		return nil, err
+	}
+	return &YouthRoster{Format: reader.format, Users: users, Errors: rowErrors}, nil
+}
+
+func (p *parser) readParentRoster(reader *rosterReader) (*ParentRoster, error) {
+	users, rowErrors, err := collect(readRows(reader, p.mode, requiredParentColumns, decodeParentUser), p.mode)
+	if err != nil {
+		return nil, err
+	}
+	return &ParentRoster{Format: reader.format, Users: users, Errors: rowErrors}, nil
+}
+
+func (p *parser) StreamAdultRoster(input io.Reader) iter.Seq2[AdultScoutbookUser, error] {
+	return streamRoster(input, p.open, p.mode, AdultMembers, requiredAdultColumns, decodeAdultUser)
+}
+
+func (p *parser) StreamYouthRoster(input io.Reader) iter.Seq2[YouthScoutbookUser, error] {
+	return streamRoster(input, p.open, p.mode, YouthMembers, requiredYouthColumns, decodeYouthUser)
+}
+
+func (p *parser) StreamParentRoster(input io.Reader) iter.Seq2[ParentScoutbookUser, error] {
+	return streamRoster(input, p.open, p.mode, ParentMembers, requiredParentColumns, decodeParentUser)
+}
+
+func decodeAdultUser(r *row) AdultScoutbookUser {
+	return AdultScoutbookUser{
+		FirstName:          r.string(firstNameColumn),
+		LastName:           r.string(lastNameColumn),
+		Email:              r.string(emailColumn),
+		Gender:             r.string(genderColumn),
+		BsaId:              r.int64(bsaIdColumn),
+		UnitNumber:         r.string(unitNumberColumn),
+		Training:           r.string(trainingColumn),
+		TrainingExpiration: r.string(trainingExpirationColumn),
+		HealthForms:        r.string(healthFormsColumn),
+		SwimClass:          r.string(swimClassColumn),
+		SwimClassDate:      r.string(swimClassDateColumn),
+		Positions:          r.string(positionsColumn),
 	}
+}
 
-	return users, nil
+func decodeYouthUser(r *row) YouthScoutbookUser {
+	return YouthScoutbookUser{
+		FirstName:          r.string(firstNameColumn),
+		LastName:           r.string(lastNameColumn),
+		BsaId:              r.int64(bsaIdColumn),
+		DateOfBirth:        r.string(dateOfBirthColumn),
+		Age:                r.int(ageColumn),
+		Gender:             r.string(genderColumn),
+		HealthForms:        r.string(healthFormsColumn),
+		SwimClass:          r.string(swimClassColumn),
+		SwimClassDate:      r.string(swimClassDateColumn),
+		Positions:          r.string(positionsColumn),
+		Patrol:             r.string(patrolColumn),
+		UnitNumber:         r.string(unitNumberColumn),
+		Training:           r.string(trainingColumn),
+		TrainingExpiration: r.string(trainingExpirationColumn),
+	}
+}
+
+func decodeParentUser(r *row) ParentScoutbookUser {
+	return ParentScoutbookUser{
+		FirstName:      r.string(firstNameColumn),
+		LastName:       r.string(lastNameColumn),
+		Email:          r.string(emailColumn),
+		Phone:          r.string(phoneColumn),
+		Relationship:   r.string(relationshipColumn),
+		YouthFirstName: r.string(youthFirstNameColumn),
+		YouthLastName:  r.string(youthLastNameColumn),
+		YouthBsaId:     r.int64(youthBsaIdColumn),
+	}
 }
 
-func (p *csvParser) ParseYouthRoster(input *os.File) ([]YouthScoutbookUser, error) {
-	r := csv.NewReader(input)
+// rosterReader reads a roster export whose title and header rows have already been read, and its format and type
+// detected.
+type rosterReader struct {
+	// next returns the next record and its line number, or io.EOF when there are no more records.
+	next       func() ([]string, int, error)
+	format     FormatVersion
+	rosterType RosterType
+	title      []string
+	header     []string
+}
+
+const byteOrderMark = "\ufeff"
+
+// newCsvRosterReader reads the title and header rows of a CSV roster from input and detects the format of the roster.
+// The raw text of both rows is kept so that formats can be told apart by their quoting.
+func newCsvRosterReader(input io.Reader, _ RosterType) (*rosterReader, error) {
+	buffered := bufio.NewReader(input)
+
+	// The first row is the title row, and the second row holds the column headers
+	var lines []string
+	for range 2 {
+		line, err := buffered.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if len(lines) == 0 { // Exports saved from Excel start with a byte order mark
+			line = strings.TrimPrefix(line, byteOrderMark)
+		}
+		if strings.TrimSpace(line) == "" { // Check for header-only or empty file
+			return nil, EmptyRosterError
+		}
+		lines = append(lines, line)
+	}
+
+	r := csv.NewReader(io.MultiReader(strings.NewReader(strings.Join(lines, "")), buffered))
 	r.LazyQuotes = true    // Allow quotes to appear in unquoted fields
 	r.FieldsPerRecord = -1 // Allow records with varying numbers of fields
-	records, err := r.ReadAll()
+
+	title, err := r.Read()
 	if err != nil {
 		return nil, err
 	}
-	if len(records) <= 2 { // Check for header-only or empty file
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) { // Check for header-only or empty file
 		return nil, EmptyRosterError
 	}
+	if err != nil {
+		return nil, err
+	}
+	r.ReuseRecord = true // Records are decoded before the next read
 
-	// Skip header rows (index 0 and 1) and process remaining records
-	var users []YouthScoutbookUser
-	for _, record := range records[2:] {
-		user := YouthScoutbookUser{
-			FirstName:           parseString(record[1]),
-			LastName:            parseString(record[2]),
-			BsaId:               parseInt64(record[3]),
-			DateOfBirth:         parseString(record[4]),
-			Age:                 parseInt(record[5]),
-			Gender:              parseString(record[6]),
-			HealthForms:         parseString(record[7]),
-			SwimClass:           parseString(record[8]),
-			SwimClassExpiration: parseString(record[9]),
-			Positions:           parseString(record[10]),
-			Patrol:              parseString(record[11]),
-			Training:            parseString(record[12]),
-			TrainingExpiration:  parseString(record[13]),
+	format, err := DetectFormat(FormatSample{TitleLine: lines[0], HeaderLine: lines[1], Title: title, Header: header})
+	if err != nil {
+		return nil, err
+	}
+
+	next := func() ([]string, int, error) {
+		record, err := r.Read()
+		if err != nil {
+			return nil, 0, err
 		}
-		users = append(users, user)
+		line, _ := r.FieldPos(0)
+		return record, line, nil
 	}
 
-	return users, nil
+	return newRosterReader(next, format, title, header), nil
+}
+
+func newRosterReader(next func() ([]string, int, error), format FormatVersion, title []string, header []string) *rosterReader {
+	reader := &rosterReader{next: next, format: format, title: title, header: header}
+	reader.rosterType = parseRosterType(reader.titleText())
+	return reader
+}
+
+// titleText returns the text of the title row.
+func (r *rosterReader) titleText() string {
+	return titleText(r.title)
+}
+
+// titleText returns the text of a title row, ignoring blank and quoted blank cells.
+func titleText(title []string) string {
+	for _, cell := range title {
+		text := parseString(strings.Trim(parseString(cell), `"`))
+		if text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+// expect returns a RosterTypeError if the roster is not of the expected type.
+func (r *rosterReader) expect(expected RosterType) error {
+	if r.rosterType != expected {
+		return &RosterTypeError{Expected: expected, Title: r.titleText()}
+	}
+	return nil
+}
+
+// streamRoster reads the title and header rows from input, checks that the roster is of the expected type and then
+// yields each remaining record mapped by decode.
+func streamRoster[T any](input io.Reader, open openFunc, mode Mode, expected RosterType, required []string, decode func(r *row) T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		reader, err := open(input, expected)
+		if err == nil {
+			err = reader.expect(expected)
+		}
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		for value, err := range readRows(reader, mode, required, decode) {
+			if !yield(value, err) {
+				return
+			}
+		}
+	}
+}
+
+// readRows yields each record after the header row mapped by decode.  Only one record is held in memory at a time.
+// Rows which cannot be read or decoded are yielded as a *ParseError, and in Strict mode the first one ends the
+// stream.
+func readRows[T any](reader *rosterReader, mode Mode, required []string, decode func(r *row) T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		columns, err := newColumnIndex(reader.header, required)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		empty := true
+		for {
+			record, line, err := reader.next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			empty = false
+
+			var csvError *csv.ParseError
+			if errors.As(err, &csvError) {
+				if !yield(zero, &ParseError{Line: csvError.Line, Reason: csvError.Err.Error(), Err: err}) || mode == Strict {
+					return
+				}
+				continue
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			current := row{columns: columns, required: required, record: record, line: line}
+			user := decode(&current)
+			if current.err != nil {
+				if !yield(zero, current.err) || mode == Strict {
+					return
+				}
+				continue
+			}
+
+			if !yield(user, nil) {
+				return
+			}
+		}
+
+		if empty {
+			yield(zero, EmptyRosterError)
+		}
+	}
+}
+
+// collect gathers every value from seq into a slice.  In Lenient mode row errors are gathered into a separate slice,
+// and any other error stops collection.  In Strict mode every error stops collection.
+func collect[T any](seq iter.Seq2[T, error], mode Mode) ([]T, []*ParseError, error) {
+	var values []T
+	var rowErrors []*ParseError
+	for value, err := range seq {
+		var parseError *ParseError
+		if mode == Lenient && errors.As(err, &parseError) {
+			rowErrors = append(rowErrors, parseError)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rowErrors, nil
+}
+
+func (p *parser) ParseFile(path string) (*Roster, error) {
+	var roster *Roster
+	err := withFile(path, func(input io.Reader) error {
+		var err error
+		roster, err = p.Parse(input)
+		return err
+	})
+	return roster, err
+}
+
+func (p *parser) ParseAdultRosterFile(path string) (*AdultRoster, error) {
+	var roster *AdultRoster
+	err := withFile(path, func(input io.Reader) error {
+		var err error
+		roster, err = p.ParseAdultRoster(input)
+		return err
+	})
+	return roster, err
+}
+
+func (p *parser) ParseYouthRosterFile(path string) (*YouthRoster, error) {
+	var roster *YouthRoster
+	err := withFile(path, func(input io.Reader) error {
+		var err error
+		roster, err = p.ParseYouthRoster(input)
+		return err
+	})
+	return roster, err
+}
+
+func (p *parser) ParseParentRosterFile(path string) (*ParentRoster, error) {
+	var roster *ParentRoster
+	err := withFile(path, func(input io.Reader) error {
+		var err error
+		roster, err = p.ParseParentRoster(input)
+		return err
+	})
+	return roster, err
+}
+
+// withFile opens the file at path and passes it to parse, closing the file when parse returns.
+func withFile(path string, parse func(input io.Reader) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func(f *os.File) {
+		err := f.Close()
+		if err != nil {
+			log.Println("Error closing roster file:", err)
+		}
+	}(file)
+
+	return parse(file)
 }
 
 const SPACE = " "
@@ -104,31 +496,32 @@ func parseString(value string) string {
 	return strings.Trim(value, SPACE)
 }
 
-func parseInt(value string) int {
+func parseInt(value string) (int, error) {
 	trimmed := strings.Trim(value, SPACE)
-	bsaId, err := strconv.Atoi(trimmed)
-	if err != nil {
-		return 0
+	if trimmed == "" {
+		return 0, nil
 	}
-	return bsaId
+	return strconv.Atoi(trimmed)
 }
 
-func parseInt64(value string) int64 {
+func parseInt64(value string) (int64, error) {
 	trimmed := strings.Trim(value, SPACE)
-	bsaId, err := strconv.ParseInt(trimmed, 10, 64)
-	if err != nil {
-		return 0
+	if trimmed == "" {
+		return 0, nil
 	}
-	return bsaId
+	return strconv.ParseInt(trimmed, 10, 64)
 }
 
-func parseYouthTraining(training string, expiration string) ([]UserStatusRecord, error) {
+// parseYouthTraining reads the training of a youth member, which Scoutbook writes with the expiration date of each
+// course in parentheses after its name.  Training without an expiration date is kept with an unknown expiration date,
+// which is Current for courses the catalog says never expire and has an UnknownStatus otherwise.
+func parseYouthTraining(training string, expiration string, catalog *CourseCatalog) ([]UserStatusRecord, error) {
 	// examples
 	// Training: "Y01 Safeguarding Youth Training Certification (Expiration Date: 03/26/2027) | SCO_800 Hazardous Weather Training (Expiration Date: 05/19/2027) |"
 	// TrainingExpiration: ""
 
 	if expiration != "" {
-		return nil, errors.New("training expiration date is not expected. Scoutbook has started sending expiration dates for youth training")
+		return nil, unexpectedYouthTrainingExpirationError
 	}
 
 	if training == "" {
@@ -146,6 +539,7 @@ func parseYouthTraining(training string, expiration string) ([]UserStatusRecord,
 
 		matches := trainingPattern.FindStringSubmatch(t)
 		if matches == nil {
+			records = append(records, TrainingRecord(strings.TrimSpace(t), date.Date{}))
 			continue
 		}
 
@@ -157,13 +551,16 @@ func parseYouthTraining(training string, expiration string) ([]UserStatusRecord,
 			return nil, fmt.Errorf("invalid date format in training: %s", t)
 		}
 
-		records = append(records, TrainingRecord(strings.TrimSpace(name), expirationDate))
+		records = append(records, catalogTrainingRecord(strings.TrimSpace(name), expirationDate, catalog))
 	}
 
 	return records, nil
 }
 
-func parseAdultTraining(training string, expiration string) ([]UserStatusRecord, error) {
+// parseAdultTraining reads the training of an adult member, which Scoutbook writes with the expiration date of each
+// course in the matching position of the expiration column.  A blank expiration date is only accepted for courses the
+// catalog says never expire.
+func parseAdultTraining(training string, expiration string, catalog *CourseCatalog) ([]UserStatusRecord, error) {
 	if training == "" {
 		return []UserStatusRecord{}, nil
 	}
@@ -182,179 +579,300 @@ func parseAdultTraining(training string, expiration string) ([]UserStatusRecord,
 			exp = expirations[i]
 		}
 
+		// Courses which never expire have no expiration date
+		if strings.TrimSpace(exp) == "" && neverExpires(t, catalog) {
+			records = append(records, TrainingRecord(t, date.Date{}))
+			continue
+		}
+
 		expirationDate, err := date.ParseDate(exp)
 		if err != nil {
 			return nil, fmt.Errorf("invalid date format in training: %s", t)
 		}
 
-		records = append(records, TrainingRecord(t, expirationDate))
+		records = append(records, catalogTrainingRecord(t, expirationDate, catalog))
 	}
 
 	return records, nil
 }
 
-func parseHealthForms(healthForms string) ([]UserStatusRecord, error) {
+// neverExpires reports whether the training is in a course the catalog knows never expires.
+func neverExpires(training string, catalog *CourseCatalog) bool {
+	code, _ := ParseCourseName(training)
+	course, ok := courseCatalogOrDefault(catalog).Course(code)
+	return ok && !course.Expires()
+}
+
+var unreadableHealthFormError = errors.New("health form is not a date followed by (AB) or (C)")
+
+// parseHealthForms reads the AB and C health forms of a member.  Forms which cannot be read are left out and returned
+// so they can be reported as warnings.
+func parseHealthForms(healthForms string) ([]UserStatusRecord, []string) {
 	// 06/19/2026(AB) (Expired) | 06/08/2022(C)
-	var healthFormPattern = regexp.MustCompile(`(?sm)(?P<date>\d{2}/\d{2}/\d{4})\s*\((?P<type>AB|C)\)(?:\s*\(Expired\))?`)
+	var healthFormPattern = regexp.MustCompile(`(?sm)(?P<date>\d{2}/\d{2}/\d{4})\s*\((?P<type>AB|C)\)(?P<expired>\s*\(Expired\))?`)
 	forms := paddedPipePattern.Split(healthForms, noLimit)
 
 	records := []UserStatusRecord{}
+	var unreadable []string
 	for _, form := range forms {
+		if strings.TrimSpace(form) == "" {
+			continue
+		}
+
 		matches := healthFormPattern.FindStringSubmatch(form)
 		if matches == nil {
+			unreadable = append(unreadable, form)
 			continue
 		}
 
-		dateStr := matches[healthFormPattern.SubexpIndex("date")]
-		formType := matches[healthFormPattern.SubexpIndex("type")]
+		completionDate, err := date.ParseDate(matches[healthFormPattern.SubexpIndex("date")])
+		if err != nil {
+			unreadable = append(unreadable, form)
+			continue
+		}
 
-		if formType == "AB" {
-			expirationDate, err := date.ParseDate(dateStr)
-			if err == nil {
-				records = append(records, HealthFormABRecord(expirationDate))
-			}
-		} else if formType == "C" {
-			expirationDate, err := date.ParseDate(dateStr)
-			if err == nil {
-				records = append(records, HealthFormCRecord(expirationDate))
-			}
+		expired := matches[healthFormPattern.SubexpIndex("expired")] != ""
+		if matches[healthFormPattern.SubexpIndex("type")] == "AB" {
+			records = append(records, HealthFormABRecord(completionDate, expired))
 		} else {
-			return []UserStatusRecord{}, fmt.Errorf("unknown health form type: [%s] in '%s'", formType, healthForms)
+			records = append(records, HealthFormCRecord(completionDate, expired))
 		}
 	}
 
-	return records, nil
+	return records, unreadable
 }
 
-func parseSwimClass(swimClass string, swimClassExpiration string) (UserStatusRecord, error) {
-	// Swimmer (01/01/2015)
+var unknownSwimClassificationError = errors.New("unknown swim classification")
+
+// Swimmer (01/01/2015)
+var swimClassPattern = regexp.MustCompile(`^(?P<classification>[^(]*?)\s*(?:\((?P<date>[^)]*)\))?$`)
+
+// parseSwimClass reads the classification and the date of the swim test.  The date comes from the Swim Class Date
+// column, or from the classification itself when that column is blank.  A classification with no date at all is kept
+// with an unknown test date, and a classification Scoutbook does not use is read as a Non-Swimmer along with an
+// unknownSwimClassificationError.
+func parseSwimClass(swimClass string, swimClassDate string) (UserStatusRecord, error) {
+	swimClass = strings.TrimSpace(swimClass)
 	if swimClass == "" {
 		return NonSwimmerRecord(), nil
 	}
 
-	// Check if they're a swimmer
-	if strings.Contains(swimClass, "Swimmer") {
-		// Parse expiration date if available
-		if swimClassExpiration != "" {
-			expirationDate, err := date.ParseDate(swimClassExpiration)
-			if err == nil {
-				return SwimmerRecord(expirationDate), nil
-			}
-		}
+	matches := swimClassPattern.FindStringSubmatch(swimClass)
+	if matches == nil {
+		return NonSwimmerRecord(), unknownSwimClassificationError
+	}
+
+	var classification SwimClassification
+	switch strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(matches[swimClassPattern.SubexpIndex("classification")])) {
+	case "swimmer":
+		classification = SwimmerClassification
+	case "beginner":
+		classification = BeginnerClassification
+	case "nonswimmer":
+		classification = NonSwimmerClassification
+	default:
+		return NonSwimmerRecord(), unknownSwimClassificationError
+	}
 
-		// If date parsing fails or no expiration, use empty date
-		return UserStatusRecord{}, errors.New("swim class expiration is invalid")
+	dateStr := strings.TrimSpace(swimClassDate)
+	if dateStr == "" {
+		dateStr = strings.TrimSpace(matches[swimClassPattern.SubexpIndex("date")])
+	}
+	if dateStr == "" {
+		return SwimClassRecord(classification, date.Date{}), nil
 	}
 
-	// Default to non-swimmer for all other cases
-	return NonSwimmerRecord(), nil
+	testDate, err := date.ParseDate(dateStr)
+	if err != nil {
+		return UserStatusRecord{}, err
+	}
+	return SwimClassRecord(classification, testDate), nil
 }
 
 // AdultScoutbookUser is a placeholder to put all the values parsed from the Scoutbook CSV
 // before it's mapped to an AdultUser
 type AdultScoutbookUser struct {
-	FirstName           string
-	LastName            string
-	Email               string
-	Gender              string
-	BsaId               int64
-	UnitNumber          string
-	Training            string
-	TrainingExpiration  string
-	HealthForms         string
-	SwimClass           string
-	SwimClassExpiration string
-	Positions           string
-}
-
-func (u *AdultScoutbookUser) ToAdultUser() (AdultUser, error) {
-	healthForms, err := parseHealthForms(u.HealthForms)
+	FirstName          string
+	LastName           string
+	Email              string
+	Gender             string
+	BsaId              int64
+	UnitNumber         string
+	Training           string
+	TrainingExpiration string
+	HealthForms        string
+	SwimClass          string
+	SwimClassDate      string
+	Positions          string
+}
+
+// ToAdultUser converts the values parsed from Scoutbook into an AdultUser.  The course catalog decides which training
+// may have no expiration date.  A value which cannot be converted is returned as a *ConversionError.  Values which
+// were replaced with an unknown value so the user could still be converted, such as an unrecognized gender, are
+// returned as warnings alongside the user.
+func (u *AdultScoutbookUser) ToAdultUser(catalog *CourseCatalog) (AdultUser, []*ConversionError, error) {
+	var warnings []*ConversionError
+	gender, err := ParseGender(u.Gender)
 	if err != nil {
-		return AdultUser{}, err
+		warnings = append(warnings, u.conversionError(genderColumn, u.Gender, err))
 	}
 
-	training, err := parseAdultTraining(u.Training, u.TrainingExpiration)
-	if err != nil {
-		return AdultUser{}, err
+	healthForms, unreadable := parseHealthForms(u.HealthForms)
+	for _, form := range unreadable {
+		warnings = append(warnings, u.conversionError(healthFormsColumn, form, unreadableHealthFormError))
 	}
 
-	swimClass, err := parseSwimClass(u.SwimClass, u.SwimClassExpiration)
+	training, err := parseAdultTraining(u.Training, u.TrainingExpiration, catalog)
 	if err != nil {
-		return AdultUser{}, err
+		return AdultUser{}, warnings, u.conversionError(trainingExpirationColumn, u.TrainingExpiration, err)
+	}
+
+	swimClass, err := parseSwimClass(u.SwimClass, u.SwimClassDate)
+	if errors.Is(err, unknownSwimClassificationError) {
+		warnings = append(warnings, u.conversionError(swimClassColumn, u.SwimClass, err))
+	} else if err != nil {
+		return AdultUser{}, warnings, u.conversionError(swimClassDateColumn, u.SwimClassDate, err)
 	}
 
 	return AdultUser{
-		Name:        u.FirstName + " " + u.LastName,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
 		BsaId:       u.BsaId,
 		Email:       u.Email,
-		Gender:      u.Gender,
+		Gender:      gender,
 		UnitNumber:  u.UnitNumber,
 		Training:    training,
 		HealthForms: healthForms,
 		SwimClass:   swimClass,
-		Positions:   strings.Split(u.Positions, " | "),
-	}, nil
+		Positions:   parsePositions(u.Positions, u.UnitNumber),
+	}, warnings, nil
 }
 
 // YouthScoutbookUser is a placeholder to put all the values parsed from the Scoutbook CSV
 // before it's mapped to a YouthUser
 type YouthScoutbookUser struct {
-	FirstName           string
-	LastName            string
-	BsaId               int64
-	Email               string
-	DateOfBirth         string
-	Age                 int
-	Gender              string
-	HealthForms         string
-	SwimClass           string
-	SwimClassExpiration string
-	Positions           string
-	Patrol              string
-	Training            string
-	TrainingExpiration  string
-}
-
-func (u *YouthScoutbookUser) ToYouthUser() (YouthUser, error) {
-	healthForms, err := parseHealthForms(u.HealthForms)
+	FirstName          string
+	LastName           string
+	BsaId              int64
+	Email              string
+	DateOfBirth        string
+	Age                int
+	Gender             string
+	HealthForms        string
+	SwimClass          string
+	SwimClassDate      string
+	Positions          string
+	Patrol             string
+	UnitNumber         string
+	Training           string
+	TrainingExpiration string
+}
+
+// ToYouthUser converts the values parsed from Scoutbook into a YouthUser, returning errors and warnings as ToAdultUser
+// does.
+func (u *YouthScoutbookUser) ToYouthUser(catalog *CourseCatalog) (YouthUser, []*ConversionError, error) {
+	var warnings []*ConversionError
+	gender, err := ParseGender(u.Gender)
 	if err != nil {
-		return YouthUser{}, err
+		warnings = append(warnings, u.conversionError(genderColumn, u.Gender, err))
 	}
 
-	bday, err := date.ParseDate(u.DateOfBirth)
-	if err != nil {
-		return YouthUser{}, nil
+	healthForms, unreadable := parseHealthForms(u.HealthForms)
+	for _, form := range unreadable {
+		warnings = append(warnings, u.conversionError(healthFormsColumn, form, unreadableHealthFormError))
 	}
 
-	training, err := parseYouthTraining(u.Training, u.TrainingExpiration)
+	bday, err := date.ParseDate(u.DateOfBirth)
 	if err != nil {
-		return YouthUser{}, err
+		return YouthUser{}, warnings, u.conversionError(dateOfBirthColumn, u.DateOfBirth, err)
 	}
 
-	swimClass, err := parseSwimClass(u.SwimClass, u.SwimClassExpiration)
+	training, err := parseYouthTraining(u.Training, u.TrainingExpiration, catalog)
+	if errors.Is(err, unexpectedYouthTrainingExpirationError) {
+		return YouthUser{}, warnings, u.conversionError(trainingExpirationColumn, u.TrainingExpiration, err)
+	}
 	if err != nil {
-		return YouthUser{}, err
+		return YouthUser{}, warnings, u.conversionError(trainingColumn, u.Training, err)
 	}
 
-	var positions []string
-	if u.Positions == "" {
-		positions = []string{}
-	} else {
-		positions = paddedPipePattern.Split(u.Positions, noLimit)
+	swimClass, err := parseSwimClass(u.SwimClass, u.SwimClassDate)
+	if errors.Is(err, unknownSwimClassificationError) {
+		warnings = append(warnings, u.conversionError(swimClassColumn, u.SwimClass, err))
+	} else if err != nil {
+		return YouthUser{}, warnings, u.conversionError(swimClassDateColumn, u.SwimClassDate, err)
 	}
 
 	return YouthUser{
-		Name:        string(u.FirstName[0]) + ". " + u.LastName,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
 		BsaId:       u.BsaId,
-		Gender:      u.Gender,
+		Gender:      gender,
 		DateOfBirth: bday,
 		Age:         u.Age,
 		Patrol:      u.Patrol,
 		Training:    training,
 		HealthForms: healthForms,
 		SwimClass:   swimClass,
-		Positions:   positions,
-	}, nil
+		UnitNumber:  u.UnitNumber,
+		Positions:   parsePositions(u.Positions, u.UnitNumber),
+	}, warnings, nil
+}
+
+// ToAdultUsers converts every member of an adult roster with ToAdultUser.  Members which cannot be converted are left
+// out and listed in the report, so one unusual value does not stop the rest of the roster from being converted.
+func ToAdultUsers(users []AdultScoutbookUser, catalog *CourseCatalog) ([]AdultUser, ConversionReport) {
+	return convertAll(users, func(u *AdultScoutbookUser) (AdultUser, []*ConversionError, error) {
+		return u.ToAdultUser(catalog)
+	})
+}
+
+// ToYouthUsers converts every member of a youth roster with ToYouthUser, as ToAdultUsers does.
+func ToYouthUsers(users []YouthScoutbookUser, catalog *CourseCatalog) ([]YouthUser, ConversionReport) {
+	return convertAll(users, func(u *YouthScoutbookUser) (YouthUser, []*ConversionError, error) {
+		return u.ToYouthUser(catalog)
+	})
+}
+
+func convertAll[S any, U any](users []S, convert func(*S) (U, []*ConversionError, error)) ([]U, ConversionReport) {
+	converted := []U{}
+	var report ConversionReport
+	for i := range users {
+		user, warnings, err := convert(&users[i])
+		report.Warnings = append(report.Warnings, warnings...)
+		if err != nil {
+			var conversionError *ConversionError
+			if !errors.As(err, &conversionError) {
+				conversionError = &ConversionError{Err: err}
+			}
+			report.Errors = append(report.Errors, conversionError)
+			continue
+		}
+		converted = append(converted, user)
+	}
+	return converted, report
+}
+
+// ParentScoutbookUser is a placeholder to put all the values parsed from the Scoutbook parent/guardian CSV before
+// it's mapped to a ParentUser.  Each row links one parent or guardian to one youth.
+type ParentScoutbookUser struct {
+	FirstName      string
+	LastName       string
+	Email          string
+	Phone          string
+	Relationship   string
+	YouthFirstName string
+	YouthLastName  string
+	YouthBsaId     int64
+}
+
+func (u *ParentScoutbookUser) ToParentUser() ParentUser {
+	return ParentUser{
+		FirstName:    u.FirstName,
+		LastName:     u.LastName,
+		Email:        u.Email,
+		Phone:        u.Phone,
+		Relationship: u.Relationship,
+	}
 }
 
 var EmptyRosterError = errors.New("roster file is empty")
@@ -5,6 +5,7 @@ import (
 	"github.com/quincy/scoutbook-tools/assertions"
 	"github.com/quincy/scoutbook-tools/date"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -43,32 +44,45 @@ func Test_RosterParserCanParseAdultRoster(t *testing.T) {
 	testCases := []struct {
 		name     string
 		filePath string
+		format   FormatVersion
 	}{
 		{
 			name:     "Original CSV format",
 			filePath: "test_resources/adult-roster-example.csv",
+			format:   OriginalFormat,
 		},
 		{
 			name:     "v20250626 CSV format",
 			filePath: "test_resources/adult-roster-example-v20250626.csv",
+			format:   V20250626Format,
+		},
+		{
+			name:     "Original CSV format with a byte order mark",
+			filePath: "test_resources/adult-roster-example-bom.csv",
+			format:   OriginalFormat,
+		},
+		{
+			name:     "v20250626 CSV format with a byte order mark",
+			filePath: "test_resources/adult-roster-example-v20250626-bom.csv",
+			format:   V20250626Format,
 		},
 	}
 
 	// Expected users should be the same for both file formats
 	expectedUsers := []AdultScoutbookUser{
-		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Gender: "F", BsaId: 1, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "05/06/2025(AB) | 05/06/2025 (C)", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Member"},
-		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Gender: "M", BsaId: 2, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "03/03/2027 | 06/07/2025", HealthForms: "05/06/2023(AB) (Expired) | 05/06/2025 (C)", SwimClass: "", SwimClassExpiration: "", Positions: "Assistant Scoutmaster"},
-		{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Gender: "F", BsaId: 3, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "05/06/2025(AB) | 05/06/2023 (C) (Expired)", SwimClass: "", SwimClassExpiration: "", Positions: "Chartered Organization Rep."},
-		{FirstName: "Dan", LastName: "Dewey", Email: "ddewey@example.com", Gender: "M", BsaId: 4, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/22/2027", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Member | Unit Advancement Chair"},
-		{FirstName: "Erin", LastName: "Eckhart", Email: "eeckhart@example.com", Gender: "F", BsaId: 5, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "04/04/2027", HealthForms: "02/20/2019(AB) (Expired) | 02/25/2021(C) (Expired)", SwimClass: "", SwimClassExpiration: "", Positions: "Assistant Scoutmaster | Assistant Scoutmaster | Unit Outdoors / Activities Chair"},
-		{FirstName: "Frank", LastName: "Faraday", Email: "ffaraday@example.com", Gender: "M", BsaId: 6, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Unit Scouter Reserve"},
-		{FirstName: "Gertrude", LastName: "Grisham", Email: "ggrisham@example.com", Gender: "F", BsaId: 7, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Executive Officer"},
-		{FirstName: "Harold", LastName: "Hunt", Email: "hhunt@example.com", Gender: "M", BsaId: 8, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Scoutmaster"},
-		{FirstName: "Irene", LastName: "Icabod", Email: "iicabod@example.com", Gender: "F", BsaId: 9, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "01/02/2027", HealthForms: "05/24/2018(AB) (Expired) | 05/24/2018(C) (Expired)", SwimClass: "", SwimClassExpiration: "", Positions: "Unit College Scouter Reserve"},
-		{FirstName: "Jeff", LastName: "Jones", Email: "jjones@example.com", Gender: "M", BsaId: 10, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "06/13/2026 | 06/05/2025", HealthForms: "03/05/2019(AB) (Expired) |", SwimClass: "Swimmer (01/01/2015)", SwimClassExpiration: "01/01/2015", Positions: "Assistant Scoutmaster | Unit Training Chair | Youth Protection Champion"},
-		{FirstName: "Kristina", LastName: "Kent", Email: "kkent@example.com", Gender: "F", BsaId: 11, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Unit Treasurer"},
-		{FirstName: "Leonard", LastName: "Lewis", Email: "llewis@example.com", Gender: "M", BsaId: 12, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Chairman | Life-to-Eagle Coordinator"},
-		{FirstName: "Mary", LastName: "Mumford", Email: "mmumford@example.com", Gender: "F", BsaId: 13, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/21/2026", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Membership Coordinator | New Member Coordinator"},
+		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Gender: "F", BsaId: 1, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "05/06/2025(AB) | 05/06/2025 (C)", SwimClass: "", SwimClassDate: "", Positions: "Committee Member"},
+		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Gender: "M", BsaId: 2, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "03/03/2027 | 06/07/2025", HealthForms: "05/06/2023(AB) (Expired) | 05/06/2025 (C)", SwimClass: "", SwimClassDate: "", Positions: "Assistant Scoutmaster"},
+		{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Gender: "F", BsaId: 3, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "05/06/2025(AB) | 05/06/2023 (C) (Expired)", SwimClass: "", SwimClassDate: "", Positions: "Chartered Organization Rep."},
+		{FirstName: "Dan", LastName: "Dewey", Email: "ddewey@example.com", Gender: "M", BsaId: 4, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/22/2027", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Committee Member | Unit Advancement Chair"},
+		{FirstName: "Erin", LastName: "Eckhart", Email: "eeckhart@example.com", Gender: "F", BsaId: 5, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "04/04/2027", HealthForms: "02/20/2019(AB) (Expired) | 02/25/2021(C) (Expired)", SwimClass: "", SwimClassDate: "", Positions: "Assistant Scoutmaster | Assistant Scoutmaster | Unit Outdoors / Activities Chair"},
+		{FirstName: "Frank", LastName: "Faraday", Email: "ffaraday@example.com", Gender: "M", BsaId: 6, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Unit Scouter Reserve"},
+		{FirstName: "Gertrude", LastName: "Grisham", Email: "ggrisham@example.com", Gender: "F", BsaId: 7, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Executive Officer"},
+		{FirstName: "Harold", LastName: "Hunt", Email: "hhunt@example.com", Gender: "M", BsaId: 8, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Scoutmaster"},
+		{FirstName: "Irene", LastName: "Icabod", Email: "iicabod@example.com", Gender: "F", BsaId: 9, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "01/02/2027", HealthForms: "05/24/2018(AB) (Expired) | 05/24/2018(C) (Expired)", SwimClass: "", SwimClassDate: "", Positions: "Unit College Scouter Reserve"},
+		{FirstName: "Jeff", LastName: "Jones", Email: "jjones@example.com", Gender: "M", BsaId: 10, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "06/13/2026 | 06/05/2025", HealthForms: "03/05/2019(AB) (Expired) |", SwimClass: "Swimmer (01/01/2015)", SwimClassDate: "01/01/2015", Positions: "Assistant Scoutmaster | Unit Training Chair | Youth Protection Champion"},
+		{FirstName: "Kristina", LastName: "Kent", Email: "kkent@example.com", Gender: "F", BsaId: 11, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Unit Treasurer"},
+		{FirstName: "Leonard", LastName: "Lewis", Email: "llewis@example.com", Gender: "M", BsaId: 12, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Committee Chairman | Life-to-Eagle Coordinator"},
+		{FirstName: "Mary", LastName: "Mumford", Email: "mmumford@example.com", Gender: "F", BsaId: 13, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/21/2026", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Committee Membership Coordinator | New Member Coordinator"},
 	}
 
 	// Run test for each test case
@@ -84,19 +98,352 @@ func Test_RosterParserCanParseAdultRoster(t *testing.T) {
 			}(csv)
 
 			// When I try to parse the input file
-			actualUsers, err := NewCsvParser().ParseAdultRoster(csv)
+			actualRoster, err := NewCsvParser().ParseAdultRoster(csv)
 			if err != nil {
 				t.Fatalf("Failed to parse roster file: %v", err)
 			}
 
 			// Then the RosterParser returns the expected users
-			if !AdultScoutbookUsers(actualUsers).ContainsExactly(expectedUsers) {
-				t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualUsers)
+			if !AdultScoutbookUsers(actualRoster.Users).ContainsExactly(expectedUsers) {
+				t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualRoster.Users)
+			}
+
+			// And the RosterParser reports the format of the input file
+			if actualRoster.Format != tc.format {
+				t.Fatalf("Expected format to be %v got %v", tc.format, actualRoster.Format)
 			}
 		})
 	}
 }
 
+func Test_RosterParserMapsColumnsByHeader(t *testing.T) {
+	// Given a roster with reordered and extra columns
+	path := "test_resources/adult-roster-reordered.csv"
+
+	// When I try to parse the input file
+	actualRoster, err := NewCsvParser().ParseAdultRosterFile(path)
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// Then the RosterParser returns the expected users
+	expectedUsers := []AdultScoutbookUser{
+		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Gender: "F", BsaId: 1, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "05/06/2025(AB) | 05/06/2025 (C)", SwimClass: "", SwimClassDate: "", Positions: "Committee Member"},
+		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Gender: "M", BsaId: 2, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "03/03/2027 | 06/07/2025", HealthForms: "05/06/2023(AB) (Expired) | 05/06/2025 (C)", SwimClass: "", SwimClassDate: "", Positions: "Assistant Scoutmaster"},
+	}
+	if !AdultScoutbookUsers(actualRoster.Users).ContainsExactly(expectedUsers) {
+		t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualRoster.Users)
+	}
+}
+
+func Test_RosterParserFailsOnMissingRequiredColumns(t *testing.T) {
+	// Given a roster without the Last Name and BSA Number columns
+	path := "test_resources/adult-roster-missing-columns.csv"
+
+	// When I try to parse the input file
+	_, err := NewCsvParser().ParseAdultRosterFile(path)
+
+	// Then the RosterParser returns an error listing the missing columns
+	var missingColumnsError *MissingColumnsError
+	if !errors.As(err, &missingColumnsError) {
+		t.Fatalf("Expected error to be MissingColumnsError got: %T -> %v", err, err)
+	}
+	if !assertions.Collection[string](missingColumnsError.Columns).ContainsExactly([]string{"Last Name", "BSA Number"}) {
+		t.Fatalf("Expected missing columns to be [Last Name BSA Number] got %v", missingColumnsError.Columns)
+	}
+}
+
+func Test_RosterParserCanParseAdultRosterFromReader(t *testing.T) {
+	// Given a roster which is not backed by a file
+	input := strings.NewReader(
+		" ,ADULT MEMBERS,,\n" +
+			" ,First Name,Last Name,BSA Number\n" +
+			"1,Alice,Ames,1\n")
+
+	// When I try to parse the input
+	actualRoster, err := NewCsvParser().ParseAdultRoster(input)
+	if err != nil {
+		t.Fatalf("Failed to parse roster: %v", err)
+	}
+
+	// Then the RosterParser returns the expected users
+	expectedUsers := []AdultScoutbookUser{{FirstName: "Alice", LastName: "Ames", BsaId: 1}}
+	if !AdultScoutbookUsers(actualRoster.Users).ContainsExactly(expectedUsers) {
+		t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualRoster.Users)
+	}
+}
+
+func Test_RosterParserCanStreamYouthRoster(t *testing.T) {
+	// Given the input file
+	csv, err := os.Open("test_resources/youth-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to open roster file: %v", err)
+	}
+	defer func(tmpFile *os.File) {
+		_ = tmpFile.Close()
+	}(csv)
+
+	// When I stream the first two members of the roster
+	var actualIds []int64
+	for user, err := range NewCsvParser().StreamYouthRoster(csv) {
+		if err != nil {
+			t.Fatalf("Failed to stream roster file: %v", err)
+		}
+		actualIds = append(actualIds, user.BsaId)
+		if len(actualIds) == 2 {
+			break
+		}
+	}
+
+	// Then the RosterParser yields the members in order
+	if !assertions.Collection[int64](actualIds).ContainsExactly([]int64{100, 101}) {
+		t.Fatalf("Expected BSA ids to be [100 101] got %v", actualIds)
+	}
+}
+
+func Test_RosterParserStreamFailsOnHeaderOnlyFile(t *testing.T) {
+	// Given a roster with a title and header but no members
+	input := strings.NewReader(
+		" ,ADULT MEMBERS,,\n" +
+			" ,First Name,Last Name,BSA Number\n")
+
+	// When I stream the roster
+	var errs []error
+	for _, err := range NewCsvParser().StreamAdultRoster(input) {
+		errs = append(errs, err)
+	}
+
+	// Then the stream yields only an EmptyRosterError
+	if len(errs) != 1 || !errors.Is(errs[0], EmptyRosterError) {
+		t.Fatalf("Expected a single EmptyRosterError got: %v", errs)
+	}
+}
+
+func Test_RosterParserFailsOnFirstBadRowInStrictMode(t *testing.T) {
+	// Given a roster with a BSA Number that is not a number
+	path := "test_resources/adult-roster-malformed.csv"
+
+	// When I try to parse the input file in strict mode
+	_, err := NewCsvParser(WithMode(Strict)).ParseAdultRosterFile(path)
+
+	// Then the RosterParser returns a ParseError describing the bad value
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("Expected error to be ParseError got: %T -> %v", err, err)
+	}
+	expected := ParseError{Line: 4, Column: "BSA Number", Value: "B-2", Reason: "not a number"}
+	if parseError.Line != expected.Line || parseError.Column != expected.Column || parseError.Value != expected.Value || parseError.Reason != expected.Reason {
+		t.Fatalf("Expected error to be %v got %v", &expected, parseError)
+	}
+}
+
+func Test_RosterParserFailsOnBlankRequiredNumberInStrictMode(t *testing.T) {
+	// Given a parent roster with a blank Youth BSA Number
+	input := strings.NewReader(
+		" ,PARENT/GUARDIAN MEMBERS,,,,,,,\n" +
+			" ,First Name,Last Name,Email,Phone,Relationship,Youth First Name,Youth Last Name,Youth BSA Number\n" +
+			"1,Pat,Parker,pp@example.com,555-1212,Mother,Sam,Parker,\n")
+
+	// When I try to parse the roster in strict mode
+	_, err := NewCsvParser(WithMode(Strict)).ParseParentRoster(input)
+
+	// Then the RosterParser returns a ParseError for the blank column
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("Expected error to be ParseError got: %T -> %v", err, err)
+	}
+	if parseError.Line != 3 || parseError.Column != "Youth BSA Number" || parseError.Reason != "required value is blank" {
+		t.Fatalf("Expected a blank Youth BSA Number error on line 3 got %v", parseError)
+	}
+}
+
+func Test_RosterParserFailsOnBlankRequiredNameInStrictMode(t *testing.T) {
+	// Given an adult roster with a blank Last Name
+	input := strings.NewReader(
+		" ,ADULT MEMBERS,,\n" +
+			" ,First Name,Last Name,BSA Number\n" +
+			"1,Alice,Ames,1\n" +
+			"2,Bob, ,2\n")
+
+	// When I try to parse the roster in strict mode
+	_, err := NewCsvParser(WithMode(Strict)).ParseAdultRoster(input)
+
+	// Then the RosterParser returns a ParseError for the blank column
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("Expected error to be ParseError got: %T -> %v", err, err)
+	}
+	if parseError.Line != 4 || parseError.Column != "Last Name" || parseError.Reason != "required value is blank" {
+		t.Fatalf("Expected a blank Last Name error on line 4 got %v", parseError)
+	}
+}
+
+func Test_RosterParserSkipsBadRowsInLenientMode(t *testing.T) {
+	// Given a roster with a bad BSA Number and a short row
+	path := "test_resources/adult-roster-malformed.csv"
+
+	// When I try to parse the input file in lenient mode
+	actualRoster, err := NewCsvParser(WithMode(Lenient)).ParseAdultRosterFile(path)
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// Then the RosterParser returns the good rows
+	var actualIds []int64
+	for _, user := range actualRoster.Users {
+		actualIds = append(actualIds, user.BsaId)
+	}
+	if !assertions.Collection[int64](actualIds).ContainsExactly([]int64{1, 4}) {
+		t.Fatalf("Expected BSA ids to be [1 4] got %v", actualIds)
+	}
+
+	// And a ParseError for each bad row
+	var actualErrors []string
+	for _, parseError := range actualRoster.Errors {
+		actualErrors = append(actualErrors, parseError.Error())
+	}
+	expectedErrors := []string{
+		`line 4, column "BSA Number": not a number: "B-2"`,
+		`line 5, column "Gender": row has 4 fields but the column is field 5`,
+	}
+	if !assertions.Collection[string](actualErrors).ContainsExactly(expectedErrors) {
+		t.Fatalf("Expected errors to be\n    %v\ngot %v", expectedErrors, actualErrors)
+	}
+}
+
+func Test_RosterParserFailsOnUnsupportedFormat(t *testing.T) {
+	// Given a roster in a layout which no registered format recognizes
+	input := strings.NewReader(
+		"ADULT MEMBERS\n" +
+			"First Name;Last Name;BSA Number\n" +
+			"Alice;Ames;1\n")
+
+	// When I try to parse the input
+	_, err := NewCsvParser().ParseAdultRoster(input)
+
+	// Then the RosterParser returns an UnsupportedFormatError
+	if !errors.Is(err, UnsupportedFormatError) {
+		t.Fatalf("Expected error to be UnsupportedFormatError got: %T -> %v", err, err)
+	}
+}
+
+func Test_RosterParserDetectsRosterTypeFromTitle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filePath string
+		expected RosterType
+	}{
+		{name: "Adult roster", filePath: "test_resources/adult-roster-example.csv", expected: AdultMembers},
+		{name: "Youth roster", filePath: "test_resources/youth-roster-example-v20250626.csv", expected: YouthMembers},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When I parse the input file without saying what type of roster it is
+			actualRoster, err := NewCsvParser().ParseFile(tc.filePath)
+			if err != nil {
+				t.Fatalf("Failed to parse roster file: %v", err)
+			}
+
+			// Then the RosterParser returns a roster of the type named by the title row
+			if actualRoster.Type != tc.expected {
+				t.Fatalf("Expected roster type to be %v got %v", tc.expected, actualRoster.Type)
+			}
+			if (actualRoster.Adult != nil) != (tc.expected == AdultMembers) || (actualRoster.Youth != nil) != (tc.expected == YouthMembers) {
+				t.Fatalf("Expected only the %v roster to be set got %+v", tc.expected, actualRoster)
+			}
+		})
+	}
+}
+
+func Test_RosterParserRejectsWrongRosterType(t *testing.T) {
+	// Given a youth roster
+	path := "test_resources/youth-roster-example.csv"
+
+	// When I try to parse it as an adult roster
+	_, err := NewCsvParser().ParseAdultRosterFile(path)
+
+	// Then the RosterParser returns a RosterTypeError
+	var rosterTypeError *RosterTypeError
+	if !errors.As(err, &rosterTypeError) {
+		t.Fatalf("Expected error to be RosterTypeError got: %T -> %v", err, err)
+	}
+	if err.Error() != `expected a roster titled "ADULT MEMBERS" but the title row is "YOUTH MEMBERS"` {
+		t.Fatalf("Unexpected error message: %v", err)
+	}
+}
+
+func Test_XlsxParserReadsTheSameUsersAsCsvParser(t *testing.T) {
+	// Given the same adult roster exported as CSV and as an Excel workbook with an extra summary sheet
+	expectedRoster, err := NewCsvParser().ParseAdultRosterFile("test_resources/adult-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// When I parse the workbook
+	actualRoster, err := NewXlsxParser().ParseAdultRosterFile("test_resources/adult-roster-example.xlsx")
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// Then the parser returns the same users from the roster sheet
+	if !AdultScoutbookUsers(actualRoster.Users).ContainsExactly(expectedRoster.Users) {
+		t.Fatalf("Expected users to be\n    %v\ngot %v", expectedRoster.Users, actualRoster.Users)
+	}
+	if actualRoster.Format != XlsxFormat {
+		t.Fatalf("Expected format to be %v got %v", XlsxFormat, actualRoster.Format)
+	}
+}
+
+func Test_XlsxParserReadsDateCellsAndInlineStrings(t *testing.T) {
+	// Given a youth roster workbook with dates of birth stored as Excel dates and positions as inline strings
+	expectedRoster, err := NewCsvParser().ParseYouthRosterFile("test_resources/youth-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// When I parse the workbook without saying what type of roster it is
+	actualRoster, err := NewParserForFile("test_resources/youth-roster-example.xlsx").ParseFile("test_resources/youth-roster-example.xlsx")
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// Then the parser returns the same users as the CSV export
+	if actualRoster.Type != YouthMembers {
+		t.Fatalf("Expected roster type to be %v got %v", YouthMembers, actualRoster.Type)
+	}
+	if !YouthScoutbookUsers(actualRoster.Youth.Users).ContainsExactly(expectedRoster.Users) {
+		t.Fatalf("Expected users to be\n    %v\ngot %v", expectedRoster.Users, actualRoster.Youth.Users)
+	}
+}
+
+func Test_XlsxParserRejectsWorkbookWithoutMatchingSheet(t *testing.T) {
+	// Given a workbook which only holds a youth roster
+	path := "test_resources/youth-roster-example.xlsx"
+
+	// When I try to parse it as an adult roster
+	_, err := NewXlsxParser().ParseAdultRosterFile(path)
+
+	// Then the parser returns a RosterTypeError
+	var rosterTypeError *RosterTypeError
+	if !errors.As(err, &rosterTypeError) {
+		t.Fatalf("Expected error to be RosterTypeError got: %T -> %v", err, err)
+	}
+}
+
+func Test_RosterParserFailsOnMissingFile(t *testing.T) {
+	// Given a path which does not exist
+	path := "test_resources/does-not-exist.csv"
+
+	// When I try to parse the file
+	_, err := NewCsvParser().ParseYouthRosterFile(path)
+
+	// Then the RosterParser returns the error from opening the file
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected error to be os.ErrNotExist got: %T -> %v", err, err)
+	}
+}
+
 func Test_RosterParserFailsOnEmptyYouthFile(t *testing.T) {
 	// Define test cases for different parser functions
 	testCases := []struct {
@@ -154,24 +501,27 @@ func Test_RosterParserCanParseYouthRoster(t *testing.T) {
 	testCases := []struct {
 		name     string
 		filePath string
+		format   FormatVersion
 	}{
 		{
 			name:     "Original CSV format",
 			filePath: "test_resources/youth-roster-example.csv",
+			format:   OriginalFormat,
 		},
 		{
 			name:     "v20250626 CSV format",
 			filePath: "test_resources/youth-roster-example-v20250626.csv",
+			format:   V20250626Format,
 		},
 	}
 
 	// Expected users should be the same for both file formats
 	expectedUsers := []YouthScoutbookUser{
-		{FirstName: "Abe", LastName: "Ames", BsaId: 100, Email: "", DateOfBirth: "07/04/2014", Age: 10, Gender: "M", HealthForms: "06/19/2026(AB) | 06/08/2026(C)", SwimClass: "", SwimClassExpiration: "", Positions: "Patrol Leader [ Vikings] Patrol | Scouts BSA [ Vikings] Patrol", Patrol: "Vikings", Training: "", TrainingExpiration: ""},
-		{FirstName: "Billy", LastName: "Brown", BsaId: 101, Email: "", DateOfBirth: "08/01/2007", Age: 17, Gender: "M", HealthForms: "06/19/2026(AB) (Expired) | 06/08/2022(C)", SwimClass: "Swimmer", SwimClassExpiration: "05/28/2019", Positions: "Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "", TrainingExpiration: ""},
-		{FirstName: "Charlie", LastName: "Carson", BsaId: 102, Email: "", DateOfBirth: "03/11/2011", Age: 14, Gender: "M", HealthForms: "06/18/2026(AB) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassExpiration: "", Positions: "Chaplain Aide | Scouts BSA [ Warthogs] Patrol", Patrol: "Warthogs", Training: "", TrainingExpiration: ""},
-		{FirstName: "Daryl", LastName: "Dewey", BsaId: 103, Email: "", DateOfBirth: "12/16/2008", Age: 16, Gender: "M", HealthForms: "06/18/2022(AB) (Expired) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassExpiration: "", Positions: "OA Unit Representative | Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "Y01 Safeguarding Youth Training Certification (Expiration Date: 03/26/2027) | SCO_800 Hazardous Weather Training (Expiration Date: 05/19/2027) |", TrainingExpiration: ""},
-		{FirstName: "Ed", LastName: "Eckhart", BsaId: 104, Email: "", DateOfBirth: "12/16/2009", Age: 17, Gender: "M", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "", Patrol: "", Training: "", TrainingExpiration: ""},
+		{FirstName: "Abe", LastName: "Ames", BsaId: 100, Email: "", DateOfBirth: "07/04/2014", Age: 10, Gender: "M", HealthForms: "06/19/2026(AB) | 06/08/2026(C)", SwimClass: "", SwimClassDate: "", Positions: "Patrol Leader [ Vikings] Patrol | Scouts BSA [ Vikings] Patrol", Patrol: "Vikings", Training: "", TrainingExpiration: ""},
+		{FirstName: "Billy", LastName: "Brown", BsaId: 101, Email: "", DateOfBirth: "08/01/2007", Age: 17, Gender: "M", HealthForms: "06/19/2026(AB) (Expired) | 06/08/2022(C)", SwimClass: "Swimmer", SwimClassDate: "05/28/2019", Positions: "Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "", TrainingExpiration: ""},
+		{FirstName: "Charlie", LastName: "Carson", BsaId: 102, Email: "", DateOfBirth: "03/11/2011", Age: 14, Gender: "M", HealthForms: "06/18/2026(AB) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassDate: "", Positions: "Chaplain Aide | Scouts BSA [ Warthogs] Patrol", Patrol: "Warthogs", Training: "", TrainingExpiration: ""},
+		{FirstName: "Daryl", LastName: "Dewey", BsaId: 103, Email: "", DateOfBirth: "12/16/2008", Age: 16, Gender: "M", HealthForms: "06/18/2022(AB) (Expired) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassDate: "", Positions: "OA Unit Representative | Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "Y01 Safeguarding Youth Training Certification (Expiration Date: 03/26/2027) | SCO_800 Hazardous Weather Training (Expiration Date: 05/19/2027) |", TrainingExpiration: ""},
+		{FirstName: "Ed", LastName: "Eckhart", BsaId: 104, Email: "", DateOfBirth: "12/16/2009", Age: 17, Gender: "M", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "", Patrol: "", Training: "", TrainingExpiration: ""},
 	}
 
 	// Run test for each test case
@@ -187,14 +537,19 @@ func Test_RosterParserCanParseYouthRoster(t *testing.T) {
 			}(csv)
 
 			// When I try to parse the input file
-			actualUsers, err := NewCsvParser().ParseYouthRoster(csv)
+			actualRoster, err := NewCsvParser().ParseYouthRoster(csv)
 			if err != nil {
 				t.Fatalf("Failed to parse roster file: %v", err)
 			}
 
 			// Then the RosterParser returns the expected users
-			if !YouthScoutbookUsers(actualUsers).ContainsExactly(expectedUsers) {
-				t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualUsers)
+			if !YouthScoutbookUsers(actualRoster.Users).ContainsExactly(expectedUsers) {
+				t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualRoster.Users)
+			}
+
+			// And the RosterParser reports the format of the input file
+			if actualRoster.Format != tc.format {
+				t.Fatalf("Expected format to be %v got %v", tc.format, actualRoster.Format)
 			}
 		})
 	}
@@ -203,24 +558,24 @@ func Test_RosterParserCanParseYouthRoster(t *testing.T) {
 func Test_AdultScoutbookUsersCanMapToAdultUsers(t *testing.T) {
 	// Given a set of AdultScoutbookUsers
 	scoutbookUser := []AdultScoutbookUser{
-		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Gender: "F", BsaId: 1, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "05/06/2025(AB) | 05/06/2025 (C)", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Member"},
-		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Gender: "M", BsaId: 2, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "03/03/2027 | 06/07/2025", HealthForms: "05/06/2025(AB) (Expired) | 05/06/2025 (C)", SwimClass: "", SwimClassExpiration: "", Positions: "Assistant Scoutmaster"},
-		{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Gender: "F", BsaId: 3, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "05/06/2025(AB) | 05/06/2023 (C) (Expired)", SwimClass: "", SwimClassExpiration: "", Positions: "Chartered Organization Rep."},
-		{FirstName: "Dan", LastName: "Dewey", Email: "ddewey@example.com", Gender: "M", BsaId: 4, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/22/2027", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Member | Unit Advancement Chair"},
-		{FirstName: "Erin", LastName: "Eckhart", Email: "eeckhart@example.com", Gender: "F", BsaId: 5, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "04/04/2027", HealthForms: "02/20/2019(AB) (Expired) | 02/25/2021(C) (Expired)", SwimClass: "", SwimClassExpiration: "", Positions: "Assistant Scoutmaster | Assistant Scoutmaster | Unit Outdoors / Activities Chair"},
-		{FirstName: "Frank", LastName: "Faraday", Email: "ffaraday@example.com", Gender: "M", BsaId: 6, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Unit Scouter Reserve"},
-		{FirstName: "Gertrude", LastName: "Grisham", Email: "ggrisham@example.com", Gender: "F", BsaId: 7, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Executive Officer"},
-		{FirstName: "Harold", LastName: "Hunt", Email: "hhunt@example.com", Gender: "M", BsaId: 8, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Scoutmaster"},
-		{FirstName: "Irene", LastName: "Icabod", Email: "iicabod@example.com", Gender: "F", BsaId: 9, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "01/02/2027", HealthForms: "05/24/2018(AB) (Expired) | 05/24/2018(C) (Expired)", SwimClass: "", SwimClassExpiration: "", Positions: "Unit College Scouter Reserve"},
-		{FirstName: "Jeff", LastName: "Jones", Email: "jjones@example.com", Gender: "M", BsaId: 10, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "06/13/2026 | 06/05/2025", HealthForms: "03/05/2019(AB) (Expired) |", SwimClass: "Swimmer (01/01/2015)", SwimClassExpiration: "01/01/2015", Positions: "Assistant Scoutmaster | Unit Training Chair | Youth Protection Champion"},
-		{FirstName: "Kristina", LastName: "Kent", Email: "kkent@example.com", Gender: "F", BsaId: 11, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Unit Treasurer"},
-		{FirstName: "Leonard", LastName: "Lewis", Email: "llewis@example.com", Gender: "M", BsaId: 12, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Chairman | Life-to-Eagle Coordinator"},
-		{FirstName: "Mary", LastName: "Mumford", Email: "mmumford@example.com", Gender: "F", BsaId: 13, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/21/2026", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "Committee Membership Coordinator | New Member Coordinator"},
+		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Gender: "F", BsaId: 1, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "05/06/2025(AB) | 05/06/2025 (C)", SwimClass: "", SwimClassDate: "", Positions: "Committee Member"},
+		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Gender: "M", BsaId: 2, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "03/03/2027 | 06/07/2025", HealthForms: "05/06/2025(AB) (Expired) | 05/06/2025 (C)", SwimClass: "", SwimClassDate: "", Positions: "Assistant Scoutmaster"},
+		{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Gender: "F", BsaId: 3, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "05/06/2025(AB) | 05/06/2023 (C) (Expired)", SwimClass: "", SwimClassDate: "", Positions: "Chartered Organization Rep."},
+		{FirstName: "Dan", LastName: "Dewey", Email: "ddewey@example.com", Gender: "M", BsaId: 4, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/22/2027", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Committee Member | Unit Advancement Chair"},
+		{FirstName: "Erin", LastName: "Eckhart", Email: "eeckhart@example.com", Gender: "F", BsaId: 5, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "04/04/2027", HealthForms: "02/20/2019(AB) (Expired) | 02/25/2021(C) (Expired)", SwimClass: "", SwimClassDate: "", Positions: "Assistant Scoutmaster | Assistant Scoutmaster | Unit Outdoors / Activities Chair"},
+		{FirstName: "Frank", LastName: "Faraday", Email: "ffaraday@example.com", Gender: "M", BsaId: 6, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03/2027", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Unit Scouter Reserve"},
+		{FirstName: "Gertrude", LastName: "Grisham", Email: "ggrisham@example.com", Gender: "F", BsaId: 7, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Executive Officer"},
+		{FirstName: "Harold", LastName: "Hunt", Email: "hhunt@example.com", Gender: "M", BsaId: 8, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Scoutmaster"},
+		{FirstName: "Irene", LastName: "Icabod", Email: "iicabod@example.com", Gender: "F", BsaId: 9, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "01/02/2027", HealthForms: "05/24/2018(AB) (Expired) | 05/24/2018(C) (Expired)", SwimClass: "", SwimClassDate: "", Positions: "Unit College Scouter Reserve"},
+		{FirstName: "Jeff", LastName: "Jones", Email: "jjones@example.com", Gender: "M", BsaId: 10, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification  | SCO_800 Hazardous Weather Training", TrainingExpiration: "06/13/2026 | 06/05/2025", HealthForms: "03/05/2019(AB) (Expired) |", SwimClass: "Swimmer (01/01/2015)", SwimClassDate: "01/01/2015", Positions: "Assistant Scoutmaster | Unit Training Chair | Youth Protection Champion"},
+		{FirstName: "Kristina", LastName: "Kent", Email: "kkent@example.com", Gender: "F", BsaId: 11, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Unit Treasurer"},
+		{FirstName: "Leonard", LastName: "Lewis", Email: "llewis@example.com", Gender: "M", BsaId: 12, UnitNumber: "Troop 77 B", Training: "", TrainingExpiration: "", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Committee Chairman | Life-to-Eagle Coordinator"},
+		{FirstName: "Mary", LastName: "Mumford", Email: "mmumford@example.com", Gender: "F", BsaId: 13, UnitNumber: "Troop 77 B", Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "02/21/2026", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "Committee Membership Coordinator | New Member Coordinator"},
 	}
 
 	var actualUsers []AdultUser
 	for _, user := range scoutbookUser {
-		adultUser, err := user.ToAdultUser()
+		adultUser, _, err := user.ToAdultUser(nil)
 		if err != nil {
 			t.Fatalf("Failed to convert AdultScoutbookUser to AdultUser: %v", err)
 		}
@@ -228,19 +583,19 @@ func Test_AdultScoutbookUsersCanMapToAdultUsers(t *testing.T) {
 	}
 
 	expectedUsers := []AdultUser{
-		{Name: "Alice Ames", Email: "aames@example.com", Gender: "F", BsaId: 1, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3))}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6)), HealthFormCRecord(date.NewDate(2025, time.May, 6))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Committee Member"}},
-		{Name: "Bob Brown", Email: "bbrown@example.com", Gender: "M", BsaId: 2, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3)), TrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2025, time.June, 7))}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6)), HealthFormCRecord(date.NewDate(2025, time.May, 6))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Assistant Scoutmaster"}},
-		{Name: "Carol Carson", Email: "ccarson@example.com", Gender: "F", BsaId: 3, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6)), HealthFormCRecord(date.NewDate(2023, time.May, 6))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Chartered Organization Rep."}},
-		{Name: "Dan Dewey", Email: "ddewey@example.com", Gender: "M", BsaId: 4, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.February, 22))}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Committee Member", "Unit Advancement Chair"}},
-		{Name: "Erin Eckhart", Email: "eeckhart@example.com", Gender: "F", BsaId: 5, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.April, 4))}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2019, time.February, 20)), HealthFormCRecord(date.NewDate(2021, time.February, 25))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Assistant Scoutmaster", "Assistant Scoutmaster", "Unit Outdoors / Activities Chair"}},
-		{Name: "Frank Faraday", Email: "ffaraday@example.com", Gender: "M", BsaId: 6, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3))}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Unit Scouter Reserve"}},
-		{Name: "Gertrude Grisham", Email: "ggrisham@example.com", Gender: "F", BsaId: 7, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Executive Officer"}},
-		{Name: "Harold Hunt", Email: "hhunt@example.com", Gender: "M", BsaId: 8, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Scoutmaster"}},
-		{Name: "Irene Icabod", Email: "iicabod@example.com", Gender: "F", BsaId: 9, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.January, 2))}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2018, time.May, 24)), HealthFormCRecord(date.NewDate(2018, time.May, 24))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Unit College Scouter Reserve"}},
-		{Name: "Jeff Jones", Email: "jjones@example.com", Gender: "M", BsaId: 10, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.June, 13)), TrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2025, time.June, 5))}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2019, time.March, 5))}, SwimClass: SwimmerRecord(date.NewDate(2015, time.January, 1)), Positions: []string{"Assistant Scoutmaster", "Unit Training Chair", "Youth Protection Champion"}},
-		{Name: "Kristina Kent", Email: "kkent@example.com", Gender: "F", BsaId: 11, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Unit Treasurer"}},
-		{Name: "Leonard Lewis", Email: "llewis@example.com", Gender: "M", BsaId: 12, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Committee Chairman", "Life-to-Eagle Coordinator"}},
-		{Name: "Mary Mumford", Email: "mmumford@example.com", Gender: "F", BsaId: 13, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.February, 21))}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{"Committee Membership Coordinator", "New Member Coordinator"}},
+		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Gender: Female, BsaId: 1, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3), nil)}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6), false), HealthFormCRecord(date.NewDate(2025, time.May, 6), false)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Committee Member", Unit: "Troop 77 B"}}},
+		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Gender: Male, BsaId: 2, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3), nil), catalogTrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2025, time.June, 7), nil)}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6), true), HealthFormCRecord(date.NewDate(2025, time.May, 6), false)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Assistant Scoutmaster", Unit: "Troop 77 B"}}},
+		{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Gender: Female, BsaId: 3, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6), false), HealthFormCRecord(date.NewDate(2023, time.May, 6), true)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Chartered Organization Rep.", Unit: "Troop 77 B"}}},
+		{FirstName: "Dan", LastName: "Dewey", Email: "ddewey@example.com", Gender: Male, BsaId: 4, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.February, 22), nil)}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Committee Member", Unit: "Troop 77 B"}, {Title: "Unit Advancement Chair", Unit: "Troop 77 B"}}},
+		{FirstName: "Erin", LastName: "Eckhart", Email: "eeckhart@example.com", Gender: Female, BsaId: 5, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.April, 4), nil)}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2019, time.February, 20), true), HealthFormCRecord(date.NewDate(2021, time.February, 25), true)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Assistant Scoutmaster", Unit: "Troop 77 B"}, {Title: "Unit Outdoors / Activities Chair", Unit: "Troop 77 B"}}},
+		{FirstName: "Frank", LastName: "Faraday", Email: "ffaraday@example.com", Gender: Male, BsaId: 6, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3), nil)}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Unit Scouter Reserve", Unit: "Troop 77 B"}}},
+		{FirstName: "Gertrude", LastName: "Grisham", Email: "ggrisham@example.com", Gender: Female, BsaId: 7, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Executive Officer", Unit: "Troop 77 B"}}},
+		{FirstName: "Harold", LastName: "Hunt", Email: "hhunt@example.com", Gender: Male, BsaId: 8, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Scoutmaster", Unit: "Troop 77 B"}}},
+		{FirstName: "Irene", LastName: "Icabod", Email: "iicabod@example.com", Gender: Female, BsaId: 9, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.January, 2), nil)}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2018, time.May, 24), true), HealthFormCRecord(date.NewDate(2018, time.May, 24), true)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Unit College Scouter Reserve", Unit: "Troop 77 B"}}},
+		{FirstName: "Jeff", LastName: "Jones", Email: "jjones@example.com", Gender: Male, BsaId: 10, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.June, 13), nil), catalogTrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2025, time.June, 5), nil)}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2019, time.March, 5), true)}, SwimClass: SwimmerRecord(date.NewDate(2015, time.January, 1)), Positions: []Position{{Title: "Assistant Scoutmaster", Unit: "Troop 77 B"}, {Title: "Unit Training Chair", Unit: "Troop 77 B"}, {Title: "Youth Protection Champion", Unit: "Troop 77 B"}}},
+		{FirstName: "Kristina", LastName: "Kent", Email: "kkent@example.com", Gender: Female, BsaId: 11, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Unit Treasurer", Unit: "Troop 77 B"}}},
+		{FirstName: "Leonard", LastName: "Lewis", Email: "llewis@example.com", Gender: Male, BsaId: 12, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Committee Chairman", Unit: "Troop 77 B"}, {Title: "Life-to-Eagle Coordinator", Unit: "Troop 77 B"}}},
+		{FirstName: "Mary", LastName: "Mumford", Email: "mmumford@example.com", Gender: Female, BsaId: 13, UnitNumber: "Troop 77 B", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.February, 21), nil)}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Committee Membership Coordinator", Unit: "Troop 77 B"}, {Title: "New Member Coordinator", Unit: "Troop 77 B"}}},
 	}
 
 	if !AdultUsers(actualUsers).ContainsExactly(expectedUsers) {
@@ -251,16 +606,16 @@ func Test_AdultScoutbookUsersCanMapToAdultUsers(t *testing.T) {
 func Test_YouthScoutbookUsersCanMapToYouthUsers(t *testing.T) {
 	// Given a set of YouthScoutbookUsers
 	scoutbookUser := []YouthScoutbookUser{
-		{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "07/04/2014", Age: 10, Gender: "M", HealthForms: "06/19/2026(AB) | 06/08/2026(C)", SwimClass: "", SwimClassExpiration: "", Positions: "Patrol Leader [ Vikings] Patrol | Scouts BSA [ Vikings] Patrol", Patrol: "Vikings", Training: "", TrainingExpiration: ""},
-		{FirstName: "Billy", LastName: "Brown", BsaId: 101, DateOfBirth: "08/01/2007", Age: 17, Gender: "M", HealthForms: "06/19/2026(AB) (Expired) | 06/08/2022(C)", SwimClass: "Swimmer", SwimClassExpiration: "05/28/2019", Positions: "Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "", TrainingExpiration: ""},
-		{FirstName: "Charlie", LastName: "Carson", BsaId: 102, DateOfBirth: "03/11/2011", Age: 14, Gender: "M", HealthForms: "06/18/2026(AB) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassExpiration: "", Positions: "Chaplain Aide | Scouts BSA [ Warthogs] Patrol", Patrol: "Warthogs", Training: "", TrainingExpiration: ""},
-		{FirstName: "Daryl", LastName: "Dewey", BsaId: 103, DateOfBirth: "12/16/2008", Age: 16, Gender: "M", HealthForms: "06/18/2022(AB) (Expired) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassExpiration: "", Positions: "OA Unit Representative | Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "Y01 Safeguarding Youth Training Certification (Expiration Date: 03/26/2027) | SCO_800 Hazardous Weather Training (Expiration Date: 05/19/2027) |", TrainingExpiration: ""},
-		{FirstName: "Ed", LastName: "Eckhart", BsaId: 104, DateOfBirth: "12/16/2009", Age: 17, Gender: "M", HealthForms: "", SwimClass: "", SwimClassExpiration: "", Positions: "", Patrol: "", Training: "", TrainingExpiration: ""},
+		{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "07/04/2014", Age: 10, Gender: "M", HealthForms: "06/19/2026(AB) | 06/08/2026(C)", SwimClass: "", SwimClassDate: "", Positions: "Patrol Leader [ Vikings] Patrol | Scouts BSA [ Vikings] Patrol", Patrol: "Vikings", Training: "", TrainingExpiration: ""},
+		{FirstName: "Billy", LastName: "Brown", BsaId: 101, DateOfBirth: "08/01/2007", Age: 17, Gender: "M", HealthForms: "06/19/2026(AB) (Expired) | 06/08/2022(C)", SwimClass: "Swimmer", SwimClassDate: "05/28/2019", Positions: "Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "", TrainingExpiration: ""},
+		{FirstName: "Charlie", LastName: "Carson", BsaId: 102, DateOfBirth: "03/11/2011", Age: 14, Gender: "M", HealthForms: "06/18/2026(AB) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassDate: "", Positions: "Chaplain Aide | Scouts BSA [ Warthogs] Patrol", Patrol: "Warthogs", Training: "", TrainingExpiration: ""},
+		{FirstName: "Daryl", LastName: "Dewey", BsaId: 103, DateOfBirth: "12/16/2008", Age: 16, Gender: "M", HealthForms: "06/18/2022(AB) (Expired) | 06/08/2022(C) (Expired)", SwimClass: "Nonswimmer", SwimClassDate: "", Positions: "OA Unit Representative | Scouts BSA [ Dreadnoughts] Patrol", Patrol: "Dreadnoughts", Training: "Y01 Safeguarding Youth Training Certification (Expiration Date: 03/26/2027) | SCO_800 Hazardous Weather Training (Expiration Date: 05/19/2027) |", TrainingExpiration: ""},
+		{FirstName: "Ed", LastName: "Eckhart", BsaId: 104, DateOfBirth: "12/16/2009", Age: 17, Gender: "M", HealthForms: "", SwimClass: "", SwimClassDate: "", Positions: "", Patrol: "", Training: "", TrainingExpiration: ""},
 	}
 
 	var actualUsers []YouthUser
 	for _, user := range scoutbookUser {
-		youthUser, err := user.ToYouthUser()
+		youthUser, _, err := user.ToYouthUser(nil)
 		if err != nil {
 			t.Fatalf("Could not convert YouthScoutbookUser to YouthUser: %v", err)
 		}
@@ -270,11 +625,11 @@ func Test_YouthScoutbookUsersCanMapToYouthUsers(t *testing.T) {
 	// YouthUsers don't have an email address from Scoutbook.  This is only added after an admin adds the email to the
 	// YouthUser during the sign-up invite workflow.
 	expectedUsers := []YouthUser{
-		{Name: "A. Ames", BsaId: 100, Email: "", Gender: "M", DateOfBirth: date.NewDate(2014, time.July, 4), Age: 10, Patrol: "Vikings", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2026, time.June, 19)), HealthFormCRecord(date.NewDate(2026, time.June, 8))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Patrol Leader [ Vikings] Patrol", "Scouts BSA [ Vikings] Patrol"}},
-		{Name: "B. Brown", BsaId: 101, Email: "", Gender: "M", DateOfBirth: date.NewDate(2007, time.August, 1), Age: 17, Patrol: "Dreadnoughts", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2026, time.June, 19)), HealthFormCRecord(date.NewDate(2022, time.June, 8))}, SwimClass: SwimmerRecord(date.NewDate(2019, time.May, 28)), Positions: []string{"Scouts BSA [ Dreadnoughts] Patrol"}},
-		{Name: "C. Carson", BsaId: 102, Email: "", Gender: "M", DateOfBirth: date.NewDate(2011, time.March, 11), Age: 14, Patrol: "Warthogs", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2026, time.June, 18)), HealthFormCRecord(date.NewDate(2022, time.June, 8))}, SwimClass: NonSwimmerRecord(), Positions: []string{"Chaplain Aide", "Scouts BSA [ Warthogs] Patrol"}},
-		{Name: "D. Dewey", BsaId: 103, Email: "", Gender: "M", DateOfBirth: date.NewDate(2008, time.December, 16), Age: 16, Patrol: "Dreadnoughts", Training: []UserStatusRecord{TrainingRecord("Y01 Safeguarding Youth Training Certification", date.NewDate(2027, time.March, 26)), TrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2027, time.May, 19))}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2022, time.June, 18)), HealthFormCRecord(date.NewDate(2022, time.June, 8))}, SwimClass: NonSwimmerRecord(), Positions: []string{"OA Unit Representative", "Scouts BSA [ Dreadnoughts] Patrol"}},
-		{Name: "E. Eckhart", BsaId: 104, Email: "", Gender: "M", DateOfBirth: date.NewDate(2009, time.December, 16), Age: 17, Patrol: "", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []string{}},
+		{FirstName: "Abe", LastName: "Ames", BsaId: 100, Email: "", Gender: Male, DateOfBirth: date.NewDate(2014, time.July, 4), Age: 10, Patrol: "Vikings", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2026, time.June, 19), false), HealthFormCRecord(date.NewDate(2026, time.June, 8), false)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Patrol Leader", Subunit: "Vikings", SubunitType: PatrolSubunit}, {Title: "Scouts BSA", Subunit: "Vikings", SubunitType: PatrolSubunit}}},
+		{FirstName: "Billy", LastName: "Brown", BsaId: 101, Email: "", Gender: Male, DateOfBirth: date.NewDate(2007, time.August, 1), Age: 17, Patrol: "Dreadnoughts", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2026, time.June, 19), true), HealthFormCRecord(date.NewDate(2022, time.June, 8), false)}, SwimClass: SwimmerRecord(date.NewDate(2019, time.May, 28)), Positions: []Position{{Title: "Scouts BSA", Subunit: "Dreadnoughts", SubunitType: PatrolSubunit}}},
+		{FirstName: "Charlie", LastName: "Carson", BsaId: 102, Email: "", Gender: Male, DateOfBirth: date.NewDate(2011, time.March, 11), Age: 14, Patrol: "Warthogs", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2026, time.June, 18), false), HealthFormCRecord(date.NewDate(2022, time.June, 8), true)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "Chaplain Aide"}, {Title: "Scouts BSA", Subunit: "Warthogs", SubunitType: PatrolSubunit}}},
+		{FirstName: "Daryl", LastName: "Dewey", BsaId: 103, Email: "", Gender: Male, DateOfBirth: date.NewDate(2008, time.December, 16), Age: 16, Patrol: "Dreadnoughts", Training: []UserStatusRecord{catalogTrainingRecord("Y01 Safeguarding Youth Training Certification", date.NewDate(2027, time.March, 26), nil), catalogTrainingRecord("SCO_800 Hazardous Weather Training", date.NewDate(2027, time.May, 19), nil)}, HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2022, time.June, 18), true), HealthFormCRecord(date.NewDate(2022, time.June, 8), true)}, SwimClass: NonSwimmerRecord(), Positions: []Position{{Title: "OA Unit Representative"}, {Title: "Scouts BSA", Subunit: "Dreadnoughts", SubunitType: PatrolSubunit}}},
+		{FirstName: "Ed", LastName: "Eckhart", BsaId: 104, Email: "", Gender: Male, DateOfBirth: date.NewDate(2009, time.December, 16), Age: 17, Patrol: "", Training: []UserStatusRecord{}, HealthForms: []UserStatusRecord{}, SwimClass: NonSwimmerRecord(), Positions: []Position{}},
 	}
 
 	if !YouthUsers(actualUsers).ContainsExactly(expectedUsers) {
@@ -282,7 +637,297 @@ func Test_YouthScoutbookUsersCanMapToYouthUsers(t *testing.T) {
 	}
 }
 
+func Test_ScoutbookUsersReportConversionErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		convert  func() error
+		expected ConversionError
+	}{
+		{
+			name: "youth date of birth",
+			convert: func() error {
+				user := YouthScoutbookUser{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "July 4th"}
+				_, _, err := user.ToYouthUser(nil)
+				return err
+			},
+			expected: ConversionError{BsaId: 100, Name: "Abe Ames", Field: "Date of Birth", Value: "July 4th"},
+		},
+		{
+			name: "youth swim class date",
+			convert: func() error {
+				user := YouthScoutbookUser{FirstName: "Billy", LastName: "Brown", BsaId: 101, DateOfBirth: "08/01/2007", SwimClass: "Swimmer", SwimClassDate: "05/28"}
+				_, _, err := user.ToYouthUser(nil)
+				return err
+			},
+			expected: ConversionError{BsaId: 101, Name: "Billy Brown", Field: "Swim Class Date", Value: "05/28"},
+		},
+		{
+			name: "adult training expiration",
+			convert: func() error {
+				user := AdultScoutbookUser{FirstName: "Alice", LastName: "Ames", BsaId: 1, Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03"}
+				_, _, err := user.ToAdultUser(nil)
+				return err
+			},
+			expected: ConversionError{BsaId: 1, Name: "Alice Ames", Field: "Expiration Date", Value: "03/03"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given a Scoutbook user with a value that cannot be converted
+			// When I convert the user
+			err := tc.convert()
+
+			// Then the conversion fails with a ConversionError naming the member and the value
+			var conversionError *ConversionError
+			if !errors.As(err, &conversionError) {
+				t.Fatalf("Expected error to be ConversionError got: %T -> %v", err, err)
+			}
+			if conversionError.BsaId != tc.expected.BsaId || conversionError.Name != tc.expected.Name || conversionError.Field != tc.expected.Field || conversionError.Value != tc.expected.Value || conversionError.Err == nil {
+				t.Fatalf("Expected error to be %v got %v", &tc.expected, conversionError)
+			}
+		})
+	}
+}
+
+func Test_ToAdultUsersConvertsTheGoodMembersAndReportsTheRest(t *testing.T) {
+	// Given a roster with a member whose training expiration cannot be read
+	scoutbookUsers := []AdultScoutbookUser{
+		{FirstName: "Alice", LastName: "Ames", BsaId: 1, HealthForms: "05/06/2025(AB)"},
+		{FirstName: "Bob", LastName: "Brown", BsaId: 2, Training: "Y01 Youth Protection Training Certification", TrainingExpiration: "03/03"},
+		{FirstName: "Carol", LastName: "Carson", BsaId: 3},
+	}
+
+	// When I convert the whole roster
+	users, report := ToAdultUsers(scoutbookUsers, nil)
+
+	// Then the other members are converted
+	var actualIds []int64
+	for _, user := range users {
+		actualIds = append(actualIds, user.BsaId)
+	}
+	if !assertions.Collection[int64](actualIds).ContainsExactly([]int64{1, 3}) {
+		t.Fatalf("Expected BSA ids to be [1 3] got %v", actualIds)
+	}
+
+	// And the member who could not be converted is reported
+	if len(report.Errors) != 1 || report.Errors[0].BsaId != 2 || report.Errors[0].Field != trainingExpirationColumn {
+		t.Fatalf("Expected a single ConversionError for member 2 got %v", report.Errors)
+	}
+}
+
+func Test_UnrecognizedGenderIsKeptAsUnknownAndReportedAsAWarning(t *testing.T) {
+	// Given an adult and a youth whose gender Scoutbook does not use
+	adults := []AdultScoutbookUser{{FirstName: "Carol", LastName: "Carson", BsaId: 3, Gender: "X"}}
+	youth := []YouthScoutbookUser{{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "07/04/2014", Gender: "Boy"}}
+
+	// When I convert the rosters
+	adultUsers, adultReport := ToAdultUsers(adults, nil)
+	youthUsers, youthReport := ToYouthUsers(youth, nil)
+
+	// Then the members are kept with an unknown gender
+	if len(adultUsers) != 1 || adultUsers[0].Gender != UnknownGender || adultReport.Errors != nil {
+		t.Fatalf("Expected the adult to be converted with an unknown gender got %v %v", adultUsers, adultReport.Errors)
+	}
+	if len(youthUsers) != 1 || youthUsers[0].Gender != UnknownGender || youthReport.Errors != nil {
+		t.Fatalf("Expected the youth to be converted with an unknown gender got %v %v", youthUsers, youthReport.Errors)
+	}
+
+	// And the raw values are reported as warnings
+	if len(adultReport.Warnings) != 1 || adultReport.Warnings[0].BsaId != 3 || adultReport.Warnings[0].Field != genderColumn || adultReport.Warnings[0].Value != "X" {
+		t.Fatalf("Expected a gender warning for member 3 got %v", adultReport.Warnings)
+	}
+	if len(youthReport.Warnings) != 1 || youthReport.Warnings[0].BsaId != 100 || youthReport.Warnings[0].Field != genderColumn || youthReport.Warnings[0].Value != "Boy" {
+		t.Fatalf("Expected a gender warning for member 100 got %v", youthReport.Warnings)
+	}
+}
+
+func Test_ToAdultUserReturnsItsWarnings(t *testing.T) {
+	// Given a single adult whose gender Scoutbook does not use
+	scoutbookUser := AdultScoutbookUser{FirstName: "Carol", LastName: "Carson", BsaId: 3, Gender: "X"}
+
+	// When I convert the adult on their own
+	user, warnings, err := scoutbookUser.ToAdultUser(nil)
+	if err != nil {
+		t.Fatalf("Failed to convert the adult: %v", err)
+	}
+
+	// Then the adult is kept with an unknown gender and the raw value is returned as a warning
+	if user.Gender != UnknownGender || len(warnings) != 1 || warnings[0].Field != genderColumn || warnings[0].Value != "X" {
+		t.Fatalf("Expected an unknown gender with a single gender warning got %v %v", user.Gender, warnings)
+	}
+}
+
+func Test_ToYouthUsersConvertsTheGoodMembersAndReportsTheRest(t *testing.T) {
+	// Given a roster with a member whose date of birth cannot be read
+	scoutbookUsers := []YouthScoutbookUser{
+		{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "July 4th"},
+		{FirstName: "Billy", LastName: "Brown", BsaId: 101, DateOfBirth: "08/01/2007"},
+	}
+
+	// When I convert the whole roster
+	users, report := ToYouthUsers(scoutbookUsers, nil)
+
+	// Then the other members are converted
+	if len(users) != 1 || users[0].BsaId != 101 {
+		t.Fatalf("Expected only member 101 to be converted got %v", users)
+	}
+
+	// And the member who could not be converted is reported
+	if len(report.Errors) != 1 || report.Errors[0].BsaId != 100 || report.Errors[0].Field != dateOfBirthColumn {
+		t.Fatalf("Expected a single ConversionError for member 100 got %v", report.Errors)
+	}
+}
+
+func Test_ParsedTrainingIsConvertedWithTheGivenCourseCatalog(t *testing.T) {
+	// Given an adult and a youth roster with a unit course which has no expiration date
+	adultInput := strings.NewReader(
+		" ,ADULT MEMBERS,,,,\n" +
+			" ,First Name,Last Name,BSA Number,Training,Expiration Date\n" +
+			"1,Alice,Ames,1,Y01 Youth Protection Training Certification | D70 Unit Specific Course,03/03/2027 |\n")
+	youthInput := strings.NewReader(
+		" ,YOUTH MEMBERS,,,,\n" +
+			" ,First Name,Last Name,BSA Number,Date of Birth,Training\n" +
+			"1,Abe,Ames,100,07/04/2014,Y01 Safeguarding Youth Training Certification (Expiration Date: 03/26/2027) | D70 Unit Specific Course\n")
+	adultRoster, err := NewCsvParser().ParseAdultRoster(adultInput)
+	if err != nil {
+		t.Fatalf("Failed to parse adult roster: %v", err)
+	}
+	youthRoster, err := NewCsvParser().ParseYouthRoster(youthInput)
+	if err != nil {
+		t.Fatalf("Failed to parse youth roster: %v", err)
+	}
+
+	// And a catalog which knows the unit course never expires
+	catalog := NewCourseCatalog()
+	catalog.Add(Course{Code: "D70", Title: "Unit Specific Course"})
+
+	// When I convert the members with the catalog
+	adults, adultReport := ToAdultUsers(adultRoster.Users, catalog)
+	youth, youthReport := ToYouthUsers(youthRoster.Users, catalog)
+	if len(adultReport.Errors) != 0 || len(youthReport.Errors) != 0 {
+		t.Fatalf("Expected no conversion errors got %v %v", adultReport.Errors, youthReport.Errors)
+	}
+
+	// Then the unit course is kept without an expiration date and Y01 is dated back from its expiration
+	expectedAdultTraining := []UserStatusRecord{
+		{Type: Training, Code: "Y01", Name: "Youth Protection Training Certification", CompletionDate: date.NewDate(2025, time.March, 3), ExpirationDate: date.NewDate(2027, time.March, 3)},
+		{Type: Training, Code: "D70", Name: "Unit Specific Course"},
+	}
+	if len(adults) != 1 || !UserStatusRecords(adults[0].Training).ContainsExactly(expectedAdultTraining) {
+		t.Fatalf("Expected adult training to be %v got %v", expectedAdultTraining, adults)
+	}
+	expectedYouthTraining := []UserStatusRecord{
+		{Type: Training, Code: "Y01", Name: "Safeguarding Youth Training Certification", CompletionDate: date.NewDate(2025, time.March, 26), ExpirationDate: date.NewDate(2027, time.March, 26)},
+		{Type: Training, Code: "D70", Name: "Unit Specific Course"},
+	}
+	if len(youth) != 1 || !UserStatusRecords(youth[0].Training).ContainsExactly(expectedYouthTraining) {
+		t.Fatalf("Expected youth training to be %v got %v", expectedYouthTraining, youth)
+	}
+}
+
+func Test_UndatedTrainingInAnUnknownCourseIsAConversionErrorForAdults(t *testing.T) {
+	// Given an adult with a unit course which has no expiration date
+	adult := AdultScoutbookUser{FirstName: "Alice", LastName: "Ames", BsaId: 1, Training: "D70 Unit Specific Course"}
+
+	// When I convert them with the default catalog, which does not know the course
+	_, _, adultErr := adult.ToAdultUser(nil)
+
+	// Then the conversion reports the training expiration
+	var conversionError *ConversionError
+	if !errors.As(adultErr, &conversionError) || conversionError.Field != trainingExpirationColumn {
+		t.Fatalf("Expected a ConversionError for the adult training expiration got: %v", adultErr)
+	}
+}
+
+func Test_UndatedTrainingInAnUnknownCourseIsKeptForYouth(t *testing.T) {
+	// Given a youth with a course which has no expiration date and is not in the catalog
+	scoutbookUsers := []YouthScoutbookUser{{FirstName: "Abe", LastName: "Ames", BsaId: 100, DateOfBirth: "07/04/2014", Training: "Y01 Safeguarding Youth Training Certification | D70 Unit Specific Course"}}
+
+	// When I convert them with the default catalog
+	users, report := ToYouthUsers(scoutbookUsers, nil)
+
+	// Then the youth is converted
+	if len(users) != 1 || len(report.Errors) != 0 {
+		t.Fatalf("Expected the youth to be converted got %v %v", users, report.Errors)
+	}
+
+	// And the courses are kept without an expiration date
+	expectedTraining := []UserStatusRecord{
+		{Type: Training, Code: "Y01", Name: "Safeguarding Youth Training Certification"},
+		{Type: Training, Code: "D70", Name: "Unit Specific Course"},
+	}
+	if !UserStatusRecords(users[0].Training).ContainsExactly(expectedTraining) {
+		t.Fatalf("Expected training to be %v got %v", expectedTraining, users[0].Training)
+	}
+
+	// And the status of the course is unknown
+	if status := users[0].Training[1].StatusAsOf(date.NewDate(2026, time.January, 1), nil).Status; status != UnknownStatus {
+		t.Fatalf("Expected the course to have an UnknownStatus got %v", status)
+	}
+}
+
+func Test_RosterParserCanParseParentRoster(t *testing.T) {
+	// Given the input file
+	path := "test_resources/parent-roster-example.csv"
+
+	// When I try to parse the input file
+	actualRoster, err := NewCsvParser().ParseParentRosterFile(path)
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// Then the RosterParser returns the expected parents
+	expectedUsers := []ParentScoutbookUser{
+		{FirstName: "Alice", LastName: "Ames", Email: "aames@example.com", Phone: "555-0101", Relationship: "Mother", YouthFirstName: "Abe", YouthLastName: "Ames", YouthBsaId: 100},
+		{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Phone: "555-0102", Relationship: "Father", YouthFirstName: "Billy", YouthLastName: "Brown", YouthBsaId: 101},
+		{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Phone: "555-0103", Relationship: "Mother", YouthFirstName: "Charlie", YouthLastName: "Carson", YouthBsaId: 102},
+		{FirstName: "Chris", LastName: "Carson", Email: "chris.carson@example.com", Phone: "", Relationship: "Father", YouthFirstName: "Charlie", YouthLastName: "Carson", YouthBsaId: 102},
+		{FirstName: "Dan", LastName: "Dewey", Email: "ddewey@example.com", Phone: "555-0104", Relationship: "Guardian", YouthFirstName: "Daryl", YouthLastName: "Dewey", YouthBsaId: 103},
+		{FirstName: "Zed", LastName: "Zimmerman", Email: "zzimmerman@example.com", Phone: "", Relationship: "Father", YouthFirstName: "Zack", YouthLastName: "Zimmerman", YouthBsaId: 999},
+	}
+	if !ParentScoutbookUsers(actualRoster.Users).ContainsExactly(expectedUsers) {
+		t.Fatalf("Expected users to be\n    %v\ngot %v", expectedUsers, actualRoster.Users)
+	}
+}
+
+func Test_LinkParentsAddsParentsToYouthByBsaId(t *testing.T) {
+	// Given some youth and the parent roster
+	youth := []YouthUser{{BsaId: 101}, {BsaId: 102}, {BsaId: 104}}
+	parentRoster, err := NewCsvParser().ParseParentRosterFile("test_resources/parent-roster-example.csv")
+	if err != nil {
+		t.Fatalf("Failed to parse roster file: %v", err)
+	}
+
+	// When I link the parents to the youth
+	unmatched := LinkParents(youth, append(parentRoster.Users, parentRoster.Users[1]))
+
+	// Then each youth has their parents
+	expectedParents := [][]ParentUser{
+		{{FirstName: "Bob", LastName: "Brown", Email: "bbrown@example.com", Phone: "555-0102", Relationship: "Father"}},
+		{{FirstName: "Carol", LastName: "Carson", Email: "ccarson@example.com", Phone: "555-0103", Relationship: "Mother"}, {FirstName: "Chris", LastName: "Carson", Email: "chris.carson@example.com", Relationship: "Father"}},
+		nil,
+	}
+	for i, y := range youth {
+		if !ParentUsers(y.Parents).ContainsExactly(expectedParents[i]) {
+			t.Fatalf("Expected parents of %d to be\n    %v\ngot %v", y.BsaId, expectedParents[i], y.Parents)
+		}
+	}
+
+	// And the parents of youth who aren't in the list are returned
+	var unmatchedIds []int64
+	for _, parent := range unmatched {
+		unmatchedIds = append(unmatchedIds, parent.YouthBsaId)
+	}
+	if !assertions.Collection[int64](unmatchedIds).ContainsExactly([]int64{100, 103, 999}) {
+		t.Fatalf("Expected unmatched youth to be [100 103 999] got %v", unmatchedIds)
+	}
+}
+
 type AdultScoutbookUsers = assertions.Collection[AdultScoutbookUser]
 type AdultUsers = assertions.Collection[AdultUser]
 type YouthScoutbookUsers = assertions.Collection[YouthScoutbookUser]
 type YouthUsers = assertions.Collection[YouthUser]
+type ParentScoutbookUsers = assertions.Collection[ParentScoutbookUser]
+type ParentUsers = assertions.Collection[ParentUser]
+type UserStatusRecords = assertions.Collection[UserStatusRecord]
@@ -2,30 +2,236 @@ package roster
 
 import (
 	"github.com/quincy/scoutbook-tools/date"
+	"slices"
 )
 
 type AdultUser struct {
-	Name        string
-	BsaId       int64
-	Email       string
-	Gender      string
-	UnitNumber  string
-	Training    []UserStatusRecord
-	HealthForms []UserStatusRecord
-	SwimClass   UserStatusRecord
-	Positions   []string
+	FirstName   string             `json:"firstName"`
+	LastName    string             `json:"lastName"`
+	BsaId       int64              `json:"bsaId"`
+	Email       string             `json:"email"`
+	Gender      Gender             `json:"gender"`
+	UnitNumber  string             `json:"unitNumber"`
+	Training    []UserStatusRecord `json:"training"`
+	HealthForms []UserStatusRecord `json:"healthForms"`
+	SwimClass   UserStatusRecord   `json:"swimClass"`
+	Positions   []Position         `json:"positions"`
 }
 
 type YouthUser struct {
-	Name        string
-	BsaId       int64
-	Email       string
-	Gender      string
-	DateOfBirth date.Date
-	Age         int
-	Patrol      string
-	Training    []UserStatusRecord
-	HealthForms []UserStatusRecord
-	SwimClass   UserStatusRecord
-	Positions   []string
+	FirstName   string             `json:"firstName"`
+	LastName    string             `json:"lastName"`
+	BsaId       int64              `json:"bsaId"`
+	Email       string             `json:"email"`
+	Gender      Gender             `json:"gender"`
+	DateOfBirth date.Date          `json:"dateOfBirth"`
+	Age         int                `json:"age"`
+	Patrol      string             `json:"patrol"`
+	UnitNumber  string             `json:"unitNumber"`
+	Training    []UserStatusRecord `json:"training"`
+	HealthForms []UserStatusRecord `json:"healthForms"`
+	SwimClass   UserStatusRecord   `json:"swimClass"`
+	Positions   []Position         `json:"positions"`
+	Parents     []ParentUser       `json:"parents"`
+}
+
+// FormattedName returns the adult's name in the given format.
+func (u AdultUser) FormattedName(format NameFormat) string {
+	return format.Format(u.FirstName, u.LastName)
+}
+
+// FormattedName returns the youth's name in the given format.
+func (u YouthUser) FormattedName(format NameFormat) string {
+	return format.Format(u.FirstName, u.LastName)
+}
+
+// AgeOn returns the youth's age on the given date, computed from their DateOfBirth.  A youth born on February 29th
+// has their birthday on March 1st in other years.
+func (u YouthUser) AgeOn(asOf date.Date) int {
+	age := asOf.Year() - u.DateOfBirth.Year()
+	if asOf.Month() < u.DateOfBirth.Month() || (asOf.Month() == u.DateOfBirth.Month() && asOf.Day() < u.DateOfBirth.Day()) {
+		age--
+	}
+	return age
+}
+
+// AgeMismatch reports whether the Age exported by Scoutbook disagrees with the age computed from the youth's
+// DateOfBirth on the date the roster was exported.
+func (u YouthUser) AgeMismatch(exported date.Date) bool {
+	return u.Age != u.AgeOn(exported)
+}
+
+// AgeMismatches returns the youth for whom AgeMismatch is true.
+func AgeMismatches(youth []YouthUser, exported date.Date) []YouthUser {
+	var mismatches []YouthUser
+	for _, u := range youth {
+		if u.AgeMismatch(exported) {
+			mismatches = append(mismatches, u)
+		}
+	}
+	return mismatches
+}
+
+// HasPosition reports whether the adult holds the position with the given title in the given patrol or den.  An empty
+// subunit matches any subunit.
+func (u AdultUser) HasPosition(title string, subunit string) bool {
+	return slices.ContainsFunc(u.Positions, func(p Position) bool { return p.Is(title, subunit) })
+}
+
+// HasPosition reports whether the youth holds the position with the given title in the given patrol or den.  An empty
+// subunit matches any subunit.
+func (u YouthUser) HasPosition(title string, subunit string) bool {
+	return slices.ContainsFunc(u.Positions, func(p Position) bool { return p.Is(title, subunit) })
+}
+
+// HasCurrentTraining reports whether the adult has training in the course with the given code, such as "Y01", which
+// has not expired today, or on the clock's date when a clock is given.  Undated training only counts when the catalog
+// says the course never expires.
+func (u AdultUser) HasCurrentTraining(code string, clock date.Clock, catalog *CourseCatalog) bool {
+	return hasCurrentTraining(u.Training, code, clockOrSystem(clock).Today(), catalog)
+}
+
+// HasCurrentTraining reports whether the youth has current training in the course with the given code, as
+// AdultUser.HasCurrentTraining does.
+func (u YouthUser) HasCurrentTraining(code string, clock date.Clock, catalog *CourseCatalog) bool {
+	return hasCurrentTraining(u.Training, code, clockOrSystem(clock).Today(), catalog)
+}
+
+// Statuses evaluates each of the adult's training, health form and swim class records today, or on the clock's date
+// when a clock is given, as StatusesAsOf does.
+func (u AdultUser) Statuses(clock date.Clock, policy *StatusPolicy) []RecordStatus {
+	return u.StatusesAsOf(clockOrSystem(clock).Today(), policy)
+}
+
+// StatusesAsOf evaluates each of the adult's training, health form and swim class records as of the given date.
+func (u AdultUser) StatusesAsOf(asOf date.Date, policy *StatusPolicy) []RecordStatus {
+	return recordStatuses(u.Training, u.HealthForms, u.SwimClass, dayOf(asOf), policy)
+}
+
+// Status returns the worst of the adult's Statuses, so an adult with any expired record is Expired.
+func (u AdultUser) Status(clock date.Clock, policy *StatusPolicy) Status {
+	return worstStatus(u.Statuses(clock, policy))
+}
+
+// StatusAsOf returns the worst status of the adult's records as of the given date, which lets a report be rerun for
+// an earlier day.
+func (u AdultUser) StatusAsOf(asOf date.Date, policy *StatusPolicy) Status {
+	return worstStatus(u.StatusesAsOf(asOf, policy))
+}
+
+// StatusDuring returns the worst status of the adult's records over a window of dates, such as a trip.
+func (u AdultUser) StatusDuring(window date.Range, policy *StatusPolicy) Status {
+	return worstStatus(recordStatuses(u.Training, u.HealthForms, u.SwimClass, window, policy))
+}
+
+// Statuses evaluates each of the youth's training, health form and swim class records today, or on the clock's date
+// when a clock is given, as StatusesAsOf does.
+func (u YouthUser) Statuses(clock date.Clock, policy *StatusPolicy) []RecordStatus {
+	return u.StatusesAsOf(clockOrSystem(clock).Today(), policy)
+}
+
+// StatusesAsOf evaluates each of the youth's training, health form and swim class records as of the given date.
+func (u YouthUser) StatusesAsOf(asOf date.Date, policy *StatusPolicy) []RecordStatus {
+	return recordStatuses(u.Training, u.HealthForms, u.SwimClass, dayOf(asOf), policy)
+}
+
+// Status returns the worst of the youth's Statuses, so a youth with any expired record is Expired.
+func (u YouthUser) Status(clock date.Clock, policy *StatusPolicy) Status {
+	return worstStatus(u.Statuses(clock, policy))
+}
+
+// StatusAsOf returns the worst status of the youth's records as of the given date, which lets a report be rerun for
+// an earlier day.
+func (u YouthUser) StatusAsOf(asOf date.Date, policy *StatusPolicy) Status {
+	return worstStatus(u.StatusesAsOf(asOf, policy))
+}
+
+// StatusDuring returns the worst status of the youth's records over a window of dates, such as a trip.
+func (u YouthUser) StatusDuring(window date.Range, policy *StatusPolicy) Status {
+	return worstStatus(recordStatuses(u.Training, u.HealthForms, u.SwimClass, window, policy))
+}
+
+// PositionsIn returns the adult's positions which belong to any of the given categories of the catalog.
+func (u AdultUser) PositionsIn(catalog *PositionCatalog, categories PositionCategory) []Position {
+	return positionsIn(u.Positions, catalog, categories)
+}
+
+// PositionsIn returns the youth's positions which belong to any of the given categories of the catalog.
+func (u YouthUser) PositionsIn(catalog *PositionCatalog, categories PositionCategory) []Position {
+	return positionsIn(u.Positions, catalog, categories)
+}
+
+// AdultsIn returns the adults who hold a position in any of the given categories of the catalog, such as the
+// DirectContact leaders.
+func AdultsIn(users []AdultUser, catalog *PositionCatalog, categories PositionCategory) []AdultUser {
+	var matches []AdultUser
+	for _, u := range users {
+		if len(u.PositionsIn(catalog, categories)) > 0 {
+			matches = append(matches, u)
+		}
+	}
+	return matches
+}
+
+// YouthIn returns the youth who hold a position in any of the given categories of the catalog, such as a
+// YouthPositionOfResponsibility.
+func YouthIn(users []YouthUser, catalog *PositionCatalog, categories PositionCategory) []YouthUser {
+	var matches []YouthUser
+	for _, u := range users {
+		if len(u.PositionsIn(catalog, categories)) > 0 {
+			matches = append(matches, u)
+		}
+	}
+	return matches
+}
+
+func positionsIn(positions []Position, catalog *PositionCatalog, categories PositionCategory) []Position {
+	catalog = catalogOrDefault(catalog)
+
+	var matches []Position
+	for _, p := range positions {
+		if catalog.Is(p, categories) {
+			matches = append(matches, p)
+		}
+	}
+	return matches
+}
+
+// ParentUser is a parent or guardian of a YouthUser.
+type ParentUser struct {
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	Email        string `json:"email"`
+	Phone        string `json:"phone"`
+	Relationship string `json:"relationship"`
+}
+
+// FormattedName returns the parent's name in the given format.
+func (u ParentUser) FormattedName(format NameFormat) string {
+	return format.Format(u.FirstName, u.LastName)
+}
+
+// LinkParents adds each parent to the Parents of the youth with the matching BsaId.  A parent listed more than once for
+// the same youth is only added once.  The parents whose youth could not be found are returned.
+func LinkParents(youth []YouthUser, parents []ParentScoutbookUser) []ParentScoutbookUser {
+	byBsaId := make(map[int64]*YouthUser, len(youth))
+	for i := range youth {
+		byBsaId[youth[i].BsaId] = &youth[i]
+	}
+
+	var unmatched []ParentScoutbookUser
+	for _, parent := range parents {
+		y, ok := byBsaId[parent.YouthBsaId]
+		if !ok {
+			unmatched = append(unmatched, parent)
+			continue
+		}
+
+		parentUser := parent.ToParentUser()
+		if !slices.Contains(y.Parents, parentUser) {
+			y.Parents = append(y.Parents, parentUser)
+		}
+	}
+
+	return unmatched
 }
@@ -2,12 +2,22 @@ package roster
 
 import (
 	"github.com/quincy/scoutbook-tools/date"
+	"strings"
 )
 
 type UserStatusRecord struct {
-	Type           RecordType
-	Name           string
-	ExpirationDate date.Date
+	Type RecordType `json:"type"`
+	// Code is the course code of a Training record, such as "Y01".
+	Code string `json:"code,omitempty"`
+	Name string `json:"name"`
+	// CompletionDate is when the form was completed, the swim test was taken or the training was taken, when it is
+	// known.
+	CompletionDate date.Date `json:"completionDate"`
+	ExpirationDate date.Date `json:"expirationDate"`
+	// Expired is true when Scoutbook marked the record as expired at the time the roster was exported.
+	Expired bool `json:"expired"`
+	// Classification is the swimming ability of a SwimClass record.
+	Classification SwimClassification `json:"classification,omitempty"`
 }
 
 type RecordType int
@@ -31,41 +41,154 @@ func (rt RecordType) String() string {
 	}
 }
 
+var recordTypeNames = map[RecordType]string{
+	Training:   "training",
+	HealthForm: "health-form",
+	SwimClass:  "swim-class",
+}
+
+func (rt RecordType) MarshalText() ([]byte, error) {
+	return marshalName(recordTypeNames, rt)
+}
+
+func (rt *RecordType) UnmarshalText(text []byte) error {
+	return unmarshalName(recordTypeNames, text, rt)
+}
+
+// TrainingRecord is a record of a training course, named as Scoutbook writes it with the course code in front of the
+// title.  A zero expiration date means the course never expires.
 func TrainingRecord(name string, expirationDate date.Date) UserStatusRecord {
+	code, title := ParseCourseName(name)
 	return UserStatusRecord{
 		Type:           Training,
-		Name:           name,
+		Code:           code,
+		Name:           title,
 		ExpirationDate: expirationDate,
 	}
 }
 
-func HealthFormABRecord(expirationDate date.Date) UserStatusRecord {
+// catalogTrainingRecord is a TrainingRecord which also knows when the training was taken.  The completion date is
+// counted back from the expiration date by the validity period of the course in the catalog, and stays unknown for
+// courses the catalog does not know or which never expire.
+func catalogTrainingRecord(name string, expirationDate date.Date, catalog *CourseCatalog) UserStatusRecord {
+	record := TrainingRecord(name, expirationDate)
+	course, ok := courseCatalogOrDefault(catalog).Course(record.Code)
+	if ok && course.Expires() && !expirationDate.IsZero() {
+		record.CompletionDate = expirationDate.AddMonths(-course.ValidMonths)
+	}
+	return record
+}
+
+// hasCurrentTraining reports whether any of the records is training in the course with the given code which has not
+// expired as of the given date.  Training without an expiration date is only current if the catalog says the course
+// never expires.
+func hasCurrentTraining(records []UserStatusRecord, code string, asOf date.Date, catalog *CourseCatalog) bool {
+	course, known := courseCatalogOrDefault(catalog).Course(code)
+	for _, r := range records {
+		if r.Type != Training || !strings.EqualFold(r.Code, strings.TrimSpace(code)) {
+			continue
+		}
+		if r.ExpirationDate.IsZero() {
+			if known && !course.Expires() {
+				return true
+			}
+			continue
+		}
+		if !r.ExpirationDate.Before(asOf) {
+			return true
+		}
+	}
+	return false
+}
+
+// HealthFormABRecord is a record of Parts A and B of the Annual Health and Medical Record, which are valid for 12
+// months from the date they were completed.
+func HealthFormABRecord(completionDate date.Date, expired bool) UserStatusRecord {
 	return UserStatusRecord{
 		Type:           HealthForm,
 		Name:           "Health Form Parts A/B",
-		ExpirationDate: expirationDate,
+		CompletionDate: completionDate,
+		ExpirationDate: completionDate.AddMonths(12),
+		Expired:        expired,
 	}
 }
 
-func HealthFormCRecord(expirationDate date.Date) UserStatusRecord {
+// HealthFormCRecord is a record of Part C of the Annual Health and Medical Record, the physical exam, which is valid
+// through the end of the 12th month after the exam.
+func HealthFormCRecord(completionDate date.Date, expired bool) UserStatusRecord {
 	return UserStatusRecord{
 		Type:           HealthForm,
 		Name:           "Health Form Part C",
-		ExpirationDate: expirationDate,
+		CompletionDate: completionDate,
+		ExpirationDate: completionDate.AddMonths(12).EndOfMonth(),
+		Expired:        expired,
 	}
 }
 
-func SwimmerRecord(expirationDate date.Date) UserStatusRecord {
-	return UserStatusRecord{
+// SwimClassification is the swimming ability a member demonstrated in their swim test.
+type SwimClassification int
+
+const (
+	NonSwimmerClassification SwimClassification = iota
+	BeginnerClassification
+	SwimmerClassification
+)
+
+func (sc SwimClassification) String() string {
+	switch sc {
+	case BeginnerClassification:
+		return "Beginner"
+	case SwimmerClassification:
+		return "Swimmer"
+	default:
+		return "Non-Swimmer"
+	}
+}
+
+var swimClassificationNames = map[SwimClassification]string{
+	NonSwimmerClassification: "non-swimmer",
+	BeginnerClassification:   "beginner",
+	SwimmerClassification:    "swimmer",
+}
+
+func (sc SwimClassification) MarshalText() ([]byte, error) {
+	return marshalName(swimClassificationNames, sc)
+}
+
+func (sc *SwimClassification) UnmarshalText(text []byte) error {
+	return unmarshalName(swimClassificationNames, text, sc)
+}
+
+// SwimClassRecord is a record of a swim test, which is valid for 12 months from the test date.  A zero test date
+// means Scoutbook does not know when the test was taken, so the record has no expiration date.
+func SwimClassRecord(classification SwimClassification, testDate date.Date) UserStatusRecord {
+	record := UserStatusRecord{
 		Type:           SwimClass,
-		Name:           "Swimmer",
-		ExpirationDate: expirationDate,
+		Name:           classification.String(),
+		CompletionDate: testDate,
+		Classification: classification,
 	}
+	if !testDate.IsZero() {
+		record.ExpirationDate = testDate.AddMonths(12)
+	}
+	return record
+}
+
+func SwimmerRecord(testDate date.Date) UserStatusRecord {
+	return SwimClassRecord(SwimmerClassification, testDate)
+}
+
+func BeginnerRecord(testDate date.Date) UserStatusRecord {
+	return SwimClassRecord(BeginnerClassification, testDate)
 }
 
+// NonSwimmerRecord is the swim class of a member who has not passed a swim test.
 func NonSwimmerRecord() UserStatusRecord {
-	return UserStatusRecord{
-		Type: SwimClass,
-		Name: "Non-Swimmer",
-	}
+	return SwimClassRecord(NonSwimmerClassification, date.Date{})
+}
+
+// TestDateUnknown reports whether the record is a Swimmer or Beginner classification without a test date, which
+// Scoutbook reports for some older classifications.  Such a classification is real but cannot be shown to be current.
+func (r UserStatusRecord) TestDateUnknown() bool {
+	return r.Type == SwimClass && r.Classification != NonSwimmerClassification && r.CompletionDate.IsZero()
 }
@@ -0,0 +1,310 @@
+package roster
+
+import (
+	"errors"
+	"github.com/quincy/scoutbook-tools/date"
+	"testing"
+	"time"
+)
+
+func Test_HealthFormRecordsComputeExpirationFromCompletionDate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		record   UserStatusRecord
+		expected date.Date
+	}{
+		{"Part A/B is valid for 12 months", HealthFormABRecord(date.NewDate(2025, time.May, 6), false), date.NewDate(2026, time.May, 6)},
+		{"Part C is valid through the end of the 12th month", HealthFormCRecord(date.NewDate(2025, time.May, 6), false), date.NewDate(2026, time.May, 31)},
+		{"Part C taken in December", HealthFormCRecord(date.NewDate(2024, time.December, 2), true), date.NewDate(2025, time.December, 31)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Then the expiration date follows the BSA rule for the part
+			if tc.record.ExpirationDate != tc.expected {
+				t.Fatalf("Expected expiration date to be %v got %v", tc.expected, tc.record.ExpirationDate)
+			}
+		})
+	}
+}
+
+func Test_ParseHealthFormsKeepsTheExpiredFlag(t *testing.T) {
+	// Given health forms with one marked expired by Scoutbook
+	healthForms := "05/06/2023(AB) (Expired) | 05/06/2025 (C)"
+
+	// When I parse the health forms
+	records, unreadable := parseHealthForms(healthForms)
+	if unreadable != nil {
+		t.Fatalf("Failed to parse health forms: %v", unreadable)
+	}
+
+	// Then the completion date and expired flag of each form are kept
+	expected := []UserStatusRecord{
+		HealthFormABRecord(date.NewDate(2023, time.May, 6), true),
+		HealthFormCRecord(date.NewDate(2025, time.May, 6), false),
+	}
+	if !UserStatusRecords(records).ContainsExactly(expected) {
+		t.Fatalf("Expected records to be\n    %v\ngot %v", expected, records)
+	}
+}
+
+func Test_MalformedHealthFormsAreReportedAsWarnings(t *testing.T) {
+	// Given a member with a health form of an unknown part and one with an impossible date
+	scoutbookUser := AdultScoutbookUser{FirstName: "Alice", LastName: "Ames", BsaId: 1, HealthForms: "05/06/2025(AB) | 05/06(AB) | 13/45/2025(C)"}
+
+	// When I convert the member
+	users, report := ToAdultUsers([]AdultScoutbookUser{scoutbookUser}, nil)
+
+	// Then the member is kept with the readable form
+	expected := []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.May, 6), false)}
+	if len(users) != 1 || !UserStatusRecords(users[0].HealthForms).ContainsExactly(expected) {
+		t.Fatalf("Expected the member to be kept with health forms %v got %v", expected, users)
+	}
+
+	// And each unreadable form is reported as a warning
+	if len(report.Warnings) != 2 {
+		t.Fatalf("Expected two health form warnings got %v", report.Warnings)
+	}
+	for i, form := range []string{"05/06(AB)", "13/45/2025(C)"} {
+		warning := report.Warnings[i]
+		if warning.Field != healthFormsColumn || warning.Value != form || !errors.Is(warning, unreadableHealthFormError) {
+			t.Fatalf("Expected a health form warning for %q got %v", form, warning)
+		}
+	}
+}
+
+func Test_ParseSwimClassReadsEveryClassification(t *testing.T) {
+	testCases := []struct {
+		swimClass     string
+		swimClassDate string
+		expected      UserStatusRecord
+	}{
+		{"Swimmer", "05/28/2019", SwimmerRecord(date.NewDate(2019, time.May, 28))},
+		{"Swimmer (01/01/2015)", "", SwimmerRecord(date.NewDate(2015, time.January, 1))},
+		{"Beginner", "06/10/2024", BeginnerRecord(date.NewDate(2024, time.June, 10))},
+		{"Nonswimmer", "", NonSwimmerRecord()},
+		{"Non-Swimmer", "06/10/2024", SwimClassRecord(NonSwimmerClassification, date.NewDate(2024, time.June, 10))},
+		{"", "", NonSwimmerRecord()},
+		{"Swimmer", "", SwimmerRecord(date.Date{})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.swimClass+" "+tc.swimClassDate, func(t *testing.T) {
+			// When I parse the swim class
+			actual, err := parseSwimClass(tc.swimClass, tc.swimClassDate)
+			if err != nil {
+				t.Fatalf("Failed to parse swim class: %v", err)
+			}
+
+			// Then the classification and test date are kept
+			if actual != tc.expected {
+				t.Fatalf("Expected swim class to be %v got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_SwimClassRecordsComputeTheValidityWindow(t *testing.T) {
+	// Given a swim test taken on 06/10/2024
+	record := BeginnerRecord(date.NewDate(2024, time.June, 10))
+
+	// Then the classification is valid for 12 months
+	if expected := date.NewDate(2025, time.June, 10); record.ExpirationDate != expected {
+		t.Fatalf("Expected expiration date to be %v got %v", expected, record.ExpirationDate)
+	}
+	if record.TestDateUnknown() {
+		t.Fatalf("Expected the test date to be known")
+	}
+}
+
+func Test_SwimmerWithoutTestDateHasUnknownTestDate(t *testing.T) {
+	// Given a Swimmer classification without a date
+	record, err := parseSwimClass("Swimmer", "")
+
+	// Then it is not an error but the test date is unknown
+	if err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+	if !record.TestDateUnknown() || record.Classification != SwimmerClassification || !record.ExpirationDate.IsZero() {
+		t.Fatalf("Expected a Swimmer with an unknown test date got %v", record)
+	}
+}
+
+func Test_UnknownSwimClassificationsAreKeptAsNonSwimmerAndReportedAsAWarning(t *testing.T) {
+	// Given a member with a swim classification Scoutbook does not use
+	scoutbookUser := AdultScoutbookUser{FirstName: "Bob", LastName: "Brown", BsaId: 2, SwimClass: "Dolphin"}
+
+	// When I convert the member
+	user, warnings, err := scoutbookUser.ToAdultUser(nil)
+	if err != nil {
+		t.Fatalf("Failed to convert the member: %v", err)
+	}
+
+	// Then the member is kept as a Non-Swimmer and the raw value is returned as a warning
+	if user.SwimClass != NonSwimmerRecord() {
+		t.Fatalf("Expected a Non-Swimmer got %v", user.SwimClass)
+	}
+	if len(warnings) != 1 || warnings[0].Field != swimClassColumn || warnings[0].Value != "Dolphin" || !errors.Is(warnings[0], unknownSwimClassificationError) {
+		t.Fatalf("Expected a single swim class warning got %v", warnings)
+	}
+}
+
+func Test_UserStatusRecordStatusAsOfDate(t *testing.T) {
+	// Given a unit which warns about training 90 days ahead
+	policy := NewStatusPolicy()
+	policy.SetWarningDays(Training, 90)
+	asOf := date.NewDate(2026, time.March, 1)
+
+	// And a unit whose catalog has a course which never expires
+	catalog := NewCourseCatalog()
+	catalog.Add(Course{Code: "D70", Title: "Unit Specific Course"})
+	unitPolicy := NewStatusPolicy()
+	unitPolicy.SetCourseCatalog(catalog)
+
+	testCases := []struct {
+		name     string
+		record   UserStatusRecord
+		policy   *StatusPolicy
+		expected RecordStatus
+	}{
+		{"current training", TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.June, 1)), nil, RecordStatus{Status: Current, DaysRemaining: 92}},
+		{"training inside the default window", TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.April, 1)), nil, RecordStatus{Status: ExpiringSoon, DaysRemaining: 31}},
+		{"training inside a wider window", TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.May, 1)), policy, RecordStatus{Status: ExpiringSoon, DaysRemaining: 61}},
+		{"training expiring today", TrainingRecord("Y01 Youth Protection Training Certification", asOf), nil, RecordStatus{Status: ExpiringSoon, DaysRemaining: 0}},
+		{"expired health form", HealthFormABRecord(date.NewDate(2025, time.February, 1), false), nil, RecordStatus{Status: Expired, DaysRemaining: -28}},
+		{"health form outside its window", HealthFormCRecord(date.NewDate(2025, time.April, 15), false), nil, RecordStatus{Status: Current, DaysRemaining: 60}},
+		{"training which never expires", TrainingRecord("S24 Scoutmaster and Assistant Scoutmaster Leader Specific Training", date.Date{}), nil, RecordStatus{Status: Current}},
+		{"undated training in an expiring course", TrainingRecord("Y01 Youth Protection Training Certification", date.Date{}), nil, RecordStatus{Status: UnknownStatus}},
+		{"undated training in an unknown course", TrainingRecord("D70 Unit Specific Course", date.Date{}), nil, RecordStatus{Status: UnknownStatus}},
+		{"undated training in a unit course which never expires", TrainingRecord("D70 Unit Specific Course", date.Date{}), unitPolicy, RecordStatus{Status: Current}},
+		{"non-swimmer", NonSwimmerRecord(), nil, RecordStatus{Status: UnknownStatus}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When I evaluate the record as of the date
+			actual := tc.record.Status(date.FixedClock(asOf), tc.policy)
+
+			// Then the status and days remaining are returned
+			tc.expected.Record = tc.record
+			if actual != tc.expected {
+				t.Fatalf("Expected status to be %v got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_UserStatusIsTheWorstRecordStatus(t *testing.T) {
+	// Given an adult with current training and an expired health form
+	adult := AdultUser{
+		Training:    []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2027, time.March, 3))},
+		HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2024, time.May, 6), true)},
+		SwimClass:   NonSwimmerRecord(),
+	}
+	asOf := date.NewDate(2026, time.March, 1)
+
+	// When I evaluate the adult
+	statuses := adult.Statuses(date.FixedClock(asOf), nil)
+	status := adult.Status(date.FixedClock(asOf), nil)
+
+	// Then every record is evaluated and the adult is expired
+	if len(statuses) != 3 || statuses[0].Status != Current || statuses[1].Status != Expired || statuses[2].Status != UnknownStatus {
+		t.Fatalf("Expected Current, Expired and Unknown records got %v", statuses)
+	}
+	if status != Expired {
+		t.Fatalf("Expected status to be Expired got %v", status)
+	}
+}
+
+func Test_UserStatusIsUnknownWithoutAKnownRecordStatus(t *testing.T) {
+	// Given an adult with no records and one who is only a Non-Swimmer
+	adults := []AdultUser{{}, {SwimClass: NonSwimmerRecord()}}
+
+	for _, adult := range adults {
+		// When I evaluate the adult
+		status := adult.StatusAsOf(date.NewDate(2026, time.March, 1), nil)
+
+		// Then the adult's status is unknown rather than current
+		if status != UnknownStatus {
+			t.Fatalf("Expected status of %v to be Unknown got %v", adult, status)
+		}
+	}
+}
+
+func Test_StatusAsOfMatchesStatusOnAFixedClock(t *testing.T) {
+	// Given an adult with training which expires soon after the as-of date
+	adult := AdultUser{
+		Training:  []UserStatusRecord{TrainingRecord("Y01 Youth Protection Training Certification", date.NewDate(2026, time.April, 1))},
+		SwimClass: NonSwimmerRecord(),
+	}
+	asOf := date.NewDate(2026, time.March, 1)
+
+	// When I evaluate the adult and the record as of the date
+	record := adult.Training[0].StatusAsOf(asOf, nil)
+	status := adult.StatusAsOf(asOf, nil)
+
+	// Then the result is the same as on a clock fixed to that date
+	if record != adult.Training[0].Status(date.FixedClock(asOf), nil) || record.Status != ExpiringSoon || record.DaysRemaining != 31 {
+		t.Fatalf("Expected the record to be expiring in 31 days got %v", record)
+	}
+	if status != adult.Status(date.FixedClock(asOf), nil) || status != ExpiringSoon {
+		t.Fatalf("Expected status to be ExpiringSoon got %v", status)
+	}
+}
+
+func Test_StatusDuringAWindowRequiresTheRecordThroughTheLastDay(t *testing.T) {
+	// Given a summer camp week and a Part A/B which expires in the middle of it
+	camp, err := date.ParseRange("07/12/2026-07/18/2026")
+	if err != nil {
+		t.Fatalf("Failed to parse range: %v", err)
+	}
+	adult := AdultUser{
+		HealthForms: []UserStatusRecord{HealthFormABRecord(date.NewDate(2025, time.July, 15), false)},
+		SwimClass:   NonSwimmerRecord(),
+	}
+
+	// When I evaluate the adult during camp
+	status := adult.StatusDuring(camp, nil)
+
+	// Then the health form is not valid for the whole week
+	if status != Expired {
+		t.Fatalf("Expected status to be Expired got %v", status)
+	}
+	if actual := adult.HealthForms[0].StatusDuring(camp, nil); actual.Status != Expired || actual.DaysRemaining != -3 {
+		t.Fatalf("Expected the health form to have expired 3 days before the end of camp got %v", actual)
+	}
+}
+
+func Test_StatusDuringAWindowChecksEveryDay(t *testing.T) {
+	// Given a summer camp week
+	camp, err := date.ParseRange("07/12/2026-07/18/2026")
+	if err != nil {
+		t.Fatalf("Failed to parse range: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		record   UserStatusRecord
+		expected RecordStatus
+	}{
+		{"health form signed in the middle of camp", HealthFormABRecord(date.NewDate(2026, time.July, 15), false), RecordStatus{Status: NotYetValid, DaysRemaining: 368}},
+		{"health form signed on the first day", HealthFormABRecord(date.NewDate(2026, time.July, 12), false), RecordStatus{Status: Current, DaysRemaining: 365}},
+		{"health form expiring in the middle of camp", HealthFormABRecord(date.NewDate(2025, time.July, 15), false), RecordStatus{Status: Expired, DaysRemaining: -3}},
+		{"health form expiring on the last day", HealthFormABRecord(date.NewDate(2025, time.July, 18), false), RecordStatus{Status: ExpiringSoon, DaysRemaining: 6}},
+		{"health form outside its window from the first day", HealthFormABRecord(date.NewDate(2025, time.August, 16), false), RecordStatus{Status: Current, DaysRemaining: 35}},
+		{"training which never expires", TrainingRecord("S24 Scoutmaster and Assistant Scoutmaster Leader Specific Training", date.Date{}), RecordStatus{Status: Current}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When I evaluate the record during camp
+			actual := tc.record.StatusDuring(camp, nil)
+
+			// Then the record has to be valid from the first day through the last
+			tc.expected.Record = tc.record
+			if actual != tc.expected {
+				t.Fatalf("Expected status to be %v got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package roster
+
+import (
+	"github.com/quincy/scoutbook-tools/assertions"
+	"github.com/quincy/scoutbook-tools/date"
+	"testing"
+	"time"
+)
+
+func Test_YouthAgeIsComputedFromDateOfBirth(t *testing.T) {
+	testCases := []struct {
+		name        string
+		dateOfBirth date.Date
+		asOf        date.Date
+		expected    int
+	}{
+		{"day before birthday", date.NewDate(2014, time.July, 4), date.NewDate(2026, time.July, 3), 11},
+		{"on birthday", date.NewDate(2014, time.July, 4), date.NewDate(2026, time.July, 4), 12},
+		{"earlier month", date.NewDate(2011, time.March, 11), date.NewDate(2026, time.January, 31), 14},
+		{"leap day birthday in a common year", date.NewDate(2012, time.February, 29), date.NewDate(2026, time.February, 28), 13},
+		{"day after leap day birthday in a common year", date.NewDate(2012, time.February, 29), date.NewDate(2026, time.March, 1), 14},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given a youth with a date of birth
+			youth := YouthUser{DateOfBirth: tc.dateOfBirth}
+
+			// When I compute their age as of the date
+			actual := youth.AgeOn(tc.asOf)
+
+			// Then the age counts only birthdays which have passed
+			if actual != tc.expected {
+				t.Fatalf("Expected age to be %d got %d", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_AgeMismatchesFindsYouthWithWrongExportedAge(t *testing.T) {
+	// Given youth exported on 06/01/2025, one of whom has the wrong Age
+	youth := []YouthUser{
+		{BsaId: 100, DateOfBirth: date.NewDate(2014, time.July, 4), Age: 10},
+		{BsaId: 101, DateOfBirth: date.NewDate(2007, time.August, 1), Age: 17},
+		{BsaId: 104, DateOfBirth: date.NewDate(2009, time.December, 16), Age: 17},
+	}
+	exported := date.NewDate(2025, time.June, 1)
+
+	// When I look for mismatched ages
+	var actualIds []int64
+	for _, u := range AgeMismatches(youth, exported) {
+		actualIds = append(actualIds, u.BsaId)
+	}
+
+	// Then only the youth whose Age disagrees with their date of birth is returned
+	if !assertions.Collection[int64](actualIds).ContainsExactly([]int64{104}) {
+		t.Fatalf("Expected BSA ids to be [104] got %v", actualIds)
+	}
+}
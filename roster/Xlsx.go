@@ -0,0 +1,340 @@
+package roster
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewXlsxParser returns a Parser for rosters exported from Scoutbook as Excel workbooks.  The sheet holding the roster
+// is found by its title row, so workbooks with extra sheets are fine.  The workbook is read into memory before parsing
+// since the format requires random access.
+func NewXlsxParser(options ...ParserOption) Parser {
+	return newParser(newXlsxRosterReader, options)
+}
+
+const workbookPath = "xl/workbook.xml"
+
+// newXlsxRosterReader reads the title and header rows of a roster from an Excel workbook.  The first sheet whose title
+// row names the expected roster type is used, or when any type will do, the first sheet whose title row names a known
+// roster type.  If no sheet matches, the first sheet is used so that the caller can report its title.
+func newXlsxRosterReader(input io.Reader, expected RosterType) (*rosterReader, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, EmptyRosterError
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: not an xlsx workbook: %v", UnsupportedFormatError, err)
+	}
+
+	book, err := openWorkbook(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var chosen [][]xlsxCell
+	for i, sheetPath := range book.sheets {
+		rows, err := book.readSheet(sheetPath)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			chosen = rows
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		rosterType := parseRosterType(titleText(cellValues(rows[0])))
+		if (expected == UnknownRosterType && rosterType != UnknownRosterType) || (expected != UnknownRosterType && rosterType == expected) {
+			chosen = rows
+			break
+		}
+	}
+
+	if len(chosen) < 2 { // Check for header-only or empty sheet
+		return nil, EmptyRosterError
+	}
+
+	header := cellValues(chosen[1])
+	records := chosen[2:]
+	next := func() ([]string, int, error) {
+		if len(records) == 0 {
+			return nil, 0, io.EOF
+		}
+		record := records[0]
+		records = records[1:]
+
+		// Workbooks leave out empty cells at the end of a row, so pad the row out to the width of the header
+		values := cellValues(record)
+		for len(values) < len(header) {
+			values = append(values, "")
+		}
+		return values, record[0].row, nil
+	}
+
+	return newRosterReader(next, XlsxFormat, cellValues(chosen[0]), header), nil
+}
+
+// xlsxCell is the value of a cell along with the number of the row holding it.
+type xlsxCell struct {
+	row   int
+	value string
+}
+
+func cellValues(cells []xlsxCell) []string {
+	values := make([]string, len(cells))
+	for i, cell := range cells {
+		values[i] = cell.value
+	}
+	return values
+}
+
+// workbook holds the parts of an xlsx archive needed to read the values of its sheets.
+type workbook struct {
+	archive       *zip.Reader
+	sheets        []string
+	sharedStrings []string
+	dateStyles    map[int]bool
+}
+
+func openWorkbook(archive *zip.Reader) (*workbook, error) {
+	var wb xlsxWorkbook
+	if err := readXml(archive, workbookPath, &wb); err != nil {
+		return nil, err
+	}
+
+	var rels xlsxRelationships
+	if err := readXml(archive, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+	targets := map[string]string{}
+	for _, rel := range rels.Relationships {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.Id] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.Id] = path.Join(path.Dir(workbookPath), rel.Target)
+		}
+	}
+
+	book := &workbook{archive: archive, dateStyles: map[int]bool{}}
+	for _, sheet := range wb.Sheets {
+		target, ok := targets[sheet.RelId]
+		if !ok {
+			return nil, fmt.Errorf("workbook sheet %q has no part", sheet.Name)
+		}
+		book.sheets = append(book.sheets, target)
+	}
+
+	var sst xlsxSharedStrings
+	if err := readXml(archive, "xl/sharedStrings.xml", &sst); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, item := range sst.Items {
+		book.sharedStrings = append(book.sharedStrings, item.String())
+	}
+
+	var styles xlsxStyles
+	if err := readXml(archive, "xl/styles.xml", &styles); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	customFormats := map[int]string{}
+	for _, numFmt := range styles.NumFmts {
+		customFormats[numFmt.Id] = numFmt.Code
+	}
+	for i, xf := range styles.CellXfs {
+		code, custom := customFormats[xf.NumFmtId]
+		if (!custom && isBuiltInDateFormat(xf.NumFmtId)) || (custom && isDateFormatCode(code)) {
+			book.dateStyles[i] = true
+		}
+	}
+
+	return book, nil
+}
+
+// readSheet returns the non-empty rows of the sheet at sheetPath.  Cells missing from a row are filled in with empty
+// values so that every value is at the index of its column.
+func (b *workbook) readSheet(sheetPath string) ([][]xlsxCell, error) {
+	var sheet xlsxSheet
+	if err := readXml(b.archive, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]xlsxCell
+	for i, r := range sheet.Rows {
+		number := r.R
+		if number == 0 {
+			number = i + 1
+		}
+
+		var cells []xlsxCell
+		empty := true
+		for _, c := range r.Cells {
+			column := len(cells)
+			if c.Ref != "" {
+				column = columnNumber(c.Ref)
+			}
+			for len(cells) < column {
+				cells = append(cells, xlsxCell{row: number})
+			}
+
+			value, err := b.cellValue(c)
+			if err != nil {
+				return nil, fmt.Errorf("%s cell %s: %w", sheetPath, c.Ref, err)
+			}
+			if value != "" {
+				empty = false
+			}
+			cells = append(cells, xlsxCell{row: number, value: value})
+		}
+
+		if !empty {
+			rows = append(rows, cells)
+		}
+	}
+
+	return rows, nil
+}
+
+// excelEpoch is day zero of the 1900 date system, adjusted for Excel treating 1900 as a leap year.
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+func (b *workbook) cellValue(c xlsxSheetCell) (string, error) {
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(c.Value)
+		if err != nil || i < 0 || i >= len(b.sharedStrings) {
+			return "", fmt.Errorf("invalid shared string %q", c.Value)
+		}
+		return b.sharedStrings[i], nil
+	case "inlineStr":
+		return c.Inline.String(), nil
+	case "", "n":
+		if c.Value != "" && b.dateStyles[c.Style] {
+			serial, err := strconv.ParseFloat(c.Value, 64)
+			if err != nil {
+				return "", fmt.Errorf("invalid date %q", c.Value)
+			}
+			return excelEpoch.AddDate(0, 0, int(serial)).Format("01/02/2006"), nil
+		}
+		return c.Value, nil
+	default:
+		return c.Value, nil
+	}
+}
+
+// columnNumber returns the zero based column of a cell reference such as "B7".
+func columnNumber(ref string) int {
+	column := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		column = column*26 + int(r-'A'+1)
+	}
+	return column - 1
+}
+
+// isBuiltInDateFormat reports whether one of the number formats built in to Excel displays a date.
+func isBuiltInDateFormat(id int) bool {
+	return (id >= 14 && id <= 17) || id == 22
+}
+
+var formatLiterals = regexp.MustCompile(`"[^"]*"|\[[^\]]*\]|\\.`)
+
+// isDateFormatCode reports whether a custom number format displays a date.
+func isDateFormatCode(code string) bool {
+	code = strings.ToLower(formatLiterals.ReplaceAllString(code, ""))
+	return strings.ContainsAny(code, "dy")
+}
+
+func readXml(archive *zip.Reader, name string, v any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if err := xml.NewDecoder(file).Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name  string `xml:"name,attr"`
+		RelId string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		Id     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is rich text, which is either a single piece of text or a list of formatted runs.
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var text strings.Builder
+	for _, run := range t.Runs {
+		text.WriteString(run.Text)
+	}
+	return text.String()
+}
+
+type xlsxStyles struct {
+	NumFmts []struct {
+		Id   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtId int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		R     int             `xml:"r,attr"`
+		Cells []xlsxSheetCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type xlsxSheetCell struct {
+	Ref    string   `xml:"r,attr"`
+	Type   string   `xml:"t,attr"`
+	Style  int      `xml:"s,attr"`
+	Value  string   `xml:"v"`
+	Inline xlsxText `xml:"is"`
+}